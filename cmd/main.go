@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"log/slog"
@@ -92,11 +93,11 @@ func main() {
 	switch {
 	case config.Username != "" && config.Password != "":
 		// login with username and password
-		if _, err = action.Login(c, config.Username, config.Password, config.Token); err != nil {
+		if _, err = action.Login(context.Background(), c, config.Username, config.Password, config.Token); err != nil {
 			log.Fatalln(err)
 		}
 	case config.JWT != "":
-		if err = action.LoginByToken(c, config.JWT); err != nil {
+		if err = action.LoginByToken(context.Background(), c, config.JWT); err != nil {
 			log.Fatalln(err)
 		}
 	default:
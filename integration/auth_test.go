@@ -3,6 +3,7 @@
 package integration_test
 
 import (
+	"context"
 	"net/url"
 	"strings"
 	"testing"
@@ -40,7 +41,7 @@ func TestLogin(t *testing.T) {
 		defer c.Close()
 
 		// login
-		if _, err := action.Login(c, username, password, ""); err != nil {
+		if _, err := action.Login(context.Background(), c, username, password, ""); err != nil {
 			t.Fatalf("Failed to login: %s", err)
 		}
 	})
@@ -54,7 +55,7 @@ func TestLogin(t *testing.T) {
 		defer c.Close()
 
 		// login with wrong password, should fail
-		if _, err := action.Login(c, username, "wrongpassword", ""); err == nil {
+		if _, err := action.Login(context.Background(), c, username, "wrongpassword", ""); err == nil {
 			t.Fatalf("Login with wrong password should fail")
 		}
 	})
@@ -85,7 +86,7 @@ func TestLoginByToken(t *testing.T) {
 	}
 
 	// login to get valid token
-	if token, err = action.Login(c, username, password, ""); err != nil {
+	if token, err = action.Login(context.Background(), c, username, password, ""); err != nil {
 		t.Fatalf("Failed to login: %s", err)
 	}
 
@@ -100,7 +101,7 @@ func TestLoginByToken(t *testing.T) {
 		defer c.Close()
 
 		// login by token
-		if err := action.LoginByToken(c, token); err != nil {
+		if err := action.LoginByToken(context.Background(), c, token); err != nil {
 			t.Fatalf("Failed to login by token: %s", err)
 		}
 	})
@@ -114,7 +115,7 @@ func TestLoginByToken(t *testing.T) {
 		defer c.Close()
 
 		// login by token with wrong token
-		if err := action.LoginByToken(c, "wrong"); err == nil {
+		if err := action.LoginByToken(context.Background(), c, "wrong"); err == nil {
 			t.Fatalf("Login by token should fail")
 		}
 	})
@@ -146,7 +147,10 @@ func TestAutoLogin(t *testing.T) {
 		defer c.Close()
 
 		// wait for auto login event to not happen
-		if err := c.Await(handler.AutoLoginEvent, awaitTimeout); err == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), awaitTimeout)
+		defer cancel()
+
+		if err := c.Await(ctx, handler.AutoLoginEvent); err == nil {
 			t.Fatalf("Auto login event should not happen")
 		}
 	})
@@ -159,12 +163,12 @@ func TestAutoLogin(t *testing.T) {
 		}
 
 		// login
-		if _, err := action.Login(c, username, password, ""); err != nil {
+		if _, err := action.Login(context.Background(), c, username, password, ""); err != nil {
 			t.Fatalf("Failed to login: %s", err)
 		}
 
 		// enable auto login by setting disableAuth to true
-		if err := action.SetSettings(c, &action.Settings{
+		if err := action.SetSettings(context.Background(), c, &action.Settings{
 			DisableAuth: utils.NewBool(true),
 		}, password); err != nil {
 			t.Fatalf("Failed to set settings: %s", err)
@@ -178,12 +182,15 @@ func TestAutoLogin(t *testing.T) {
 		}
 
 		// wait for auto login event to happen
-		if err := c.Await(handler.AutoLoginEvent, awaitTimeout); err != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), awaitTimeout)
+		defer cancel()
+
+		if err := c.Await(ctx, handler.AutoLoginEvent); err != nil {
 			t.Fatalf("Auto login event should happen")
 		}
 
 		// enable auth again
-		if err := action.SetSettings(c, &action.Settings{
+		if err := action.SetSettings(context.Background(), c, &action.Settings{
 			DisableAuth: utils.NewBool(false),
 		}, password); err != nil {
 			t.Fatalf("Failed to set settings: %s", err)
@@ -217,45 +224,45 @@ func TestChangePassword(t *testing.T) {
 	defer c.Close()
 
 	t.Run("Change password with correct current password", func(t *testing.T) {
-		if _, err := action.Login(c, username, password, ""); err != nil {
+		if _, err := action.Login(context.Background(), c, username, password, ""); err != nil {
 			t.Fatalf("Failed to login: %s", err)
 		}
 
 		// change password
-		if err := action.ChangePassword(c, password, temporaryPassword); err != nil {
+		if err := action.ChangePassword(context.Background(), c, password, temporaryPassword); err != nil {
 			t.Fatalf("Failed to change password: %s", err)
 		}
 
-		if err := action.Logout(c); err != nil {
+		if err := action.Logout(context.Background(), c); err != nil {
 			t.Fatalf("Failed to logout: %s", err)
 		}
 	})
 
 	t.Run("Change password with wrong current password", func(t *testing.T) {
-		if _, err := action.Login(c, username, temporaryPassword, ""); err != nil {
+		if _, err := action.Login(context.Background(), c, username, temporaryPassword, ""); err != nil {
 			t.Fatalf("Failed to login: %s", err)
 		}
 
 		// change password with wrong current password, should fail
-		if err := action.ChangePassword(c, "wrongpassword", password); err == nil {
+		if err := action.ChangePassword(context.Background(), c, "wrongpassword", password); err == nil {
 			t.Fatalf("Change password with wrong current password should fail")
 		}
 
-		if err := action.Logout(c); err != nil {
+		if err := action.Logout(context.Background(), c); err != nil {
 			t.Fatalf("Failed to logout: %s", err)
 		}
 	})
 
 	t.Run("Change password back to original password", func(t *testing.T) {
-		if _, err := action.Login(c, username, temporaryPassword, ""); err != nil {
+		if _, err := action.Login(context.Background(), c, username, temporaryPassword, ""); err != nil {
 			t.Fatalf("Failed to login: %s", err)
 		}
 
-		if err := action.ChangePassword(c, temporaryPassword, password); err != nil {
+		if err := action.ChangePassword(context.Background(), c, temporaryPassword, password); err != nil {
 			t.Fatalf("Failed to change password back to original password: %s", err)
 		}
 
-		if err := action.Logout(c); err != nil {
+		if err := action.Logout(context.Background(), c); err != nil {
 			t.Fatalf("Failed to logout: %s", err)
 		}
 	})
@@ -285,31 +292,31 @@ func TestLogout(t *testing.T) {
 	}
 	defer c.Close()
 
-	if _, err := action.Login(c, username, password, ""); err != nil {
+	if _, err := action.Login(context.Background(), c, username, password, ""); err != nil {
 		t.Fatalf("Failed to login: %s", err)
 	}
 
 	// try to change password, should work as we are logged in
-	if err := action.ChangePassword(c, password, temporaryPassword); err != nil {
+	if err := action.ChangePassword(context.Background(), c, password, temporaryPassword); err != nil {
 		t.Fatalf("Failed to change password: %s", err)
 	}
 
 	// logout
-	if err := action.Logout(c); err != nil {
+	if err := action.Logout(context.Background(), c); err != nil {
 		t.Fatalf("Failed to logout: %s", err)
 	}
 
 	// try to change password, should fail as we are logged out
-	if err := action.ChangePassword(c, temporaryPassword, password); err == nil {
+	if err := action.ChangePassword(context.Background(), c, temporaryPassword, password); err == nil {
 		t.Fatalf("Change password should fail as we are logged out")
 	}
 
-	if _, err := action.Login(c, username, temporaryPassword, ""); err != nil {
+	if _, err := action.Login(context.Background(), c, username, temporaryPassword, ""); err != nil {
 		t.Fatalf("Failed to login: %s", err)
 	}
 
 	// change password back to original password
-	if err := action.ChangePassword(c, temporaryPassword, password); err != nil {
+	if err := action.ChangePassword(context.Background(), c, temporaryPassword, password); err != nil {
 		t.Fatalf("Failed to change password back to original password: %s", err)
 	}
 }
@@ -338,7 +345,7 @@ func Test2fa(t *testing.T) {
 		defer c.Close()
 
 		// prepare 2fa
-		totpUri, err := action.Prepare2FA(c, password)
+		totpUri, err := action.Prepare2FA(context.Background(), c, password)
 		if err != nil {
 			t.Fatalf("Failed to prepare 2fa: %s", err)
 		}
@@ -349,7 +356,7 @@ func Test2fa(t *testing.T) {
 		}
 
 		// check if 2fa is not enabled
-		status, err := action.TwoFAStatus(c)
+		status, err := action.TwoFAStatus(context.Background(), c)
 		if err != nil {
 			t.Fatalf("Failed to get 2fa status: %s", err)
 		}
@@ -367,7 +374,7 @@ func Test2fa(t *testing.T) {
 		defer c.Close()
 
 		// prepare 2fa
-		totpUri, err := action.Prepare2FA(c, password)
+		totpUri, err := action.Prepare2FA(context.Background(), c, password)
 		if err != nil {
 			t.Fatalf("Failed to prepare 2fa: %s", err)
 		}
@@ -378,12 +385,12 @@ func Test2fa(t *testing.T) {
 		}
 
 		// enable 2fa
-		if err := action.Save2FA(c, password); err != nil {
+		if err := action.Save2FA(context.Background(), c, password); err != nil {
 			t.Fatalf("Failed to enable 2fa: %s", err)
 		}
 
 		// check if 2fa is enabled
-		status, err := action.TwoFAStatus(c)
+		status, err := action.TwoFAStatus(context.Background(), c)
 		if err != nil {
 			t.Fatalf("Failed to get 2fa status: %s", err)
 		}
@@ -393,12 +400,12 @@ func Test2fa(t *testing.T) {
 		}
 
 		// disable 2fa
-		if err := action.Disable2FA(c, password); err != nil {
+		if err := action.Disable2FA(context.Background(), c, password); err != nil {
 			t.Fatalf("Failed to disable 2fa: %s", err)
 		}
 
 		// check if 2fa is disabled
-		status, err = action.TwoFAStatus(c)
+		status, err = action.TwoFAStatus(context.Background(), c)
 		if err != nil {
 			t.Fatalf("Failed to get 2fa status: %s", err)
 		}
@@ -416,7 +423,7 @@ func Test2fa(t *testing.T) {
 		defer c.Close()
 
 		// prepare 2fa
-		totpUri, err := action.Prepare2FA(c, password)
+		totpUri, err := action.Prepare2FA(context.Background(), c, password)
 		if err != nil {
 			t.Fatalf("Failed to prepare 2fa: %s", err)
 		}
@@ -427,12 +434,12 @@ func Test2fa(t *testing.T) {
 		}
 
 		// enable 2fa
-		if err := action.Save2FA(c, password); err != nil {
+		if err := action.Save2FA(context.Background(), c, password); err != nil {
 			t.Fatalf("Failed to enable 2fa: %s", err)
 		}
 
 		// verify 2fa, should fail as it's invalid
-		valid, err := action.VerifyToken(c, password, "wrongtoken")
+		valid, err := action.VerifyToken(context.Background(), c, password, "wrongtoken")
 		if err == nil {
 			t.Fatalf("Token should be invalid")
 		}
@@ -455,7 +462,7 @@ func Test2fa(t *testing.T) {
 		}
 
 		// verify token, should be valid
-		valid, err = action.VerifyToken(c, password, token)
+		valid, err = action.VerifyToken(context.Background(), c, password, token)
 		if err != nil {
 			t.Fatalf("Failed to verify token: %s", err)
 		}
@@ -465,17 +472,17 @@ func Test2fa(t *testing.T) {
 		}
 
 		// logout
-		if err := action.Logout(c); err != nil {
+		if err := action.Logout(context.Background(), c); err != nil {
 			t.Fatalf("Failed to logout: %s", err)
 		}
 
 		// login again with 2fa
-		if _, err := action.Login(c, username, password, token); err != nil {
+		if _, err := action.Login(context.Background(), c, username, password, token); err != nil {
 			t.Fatalf("Failed to login: %s", err)
 		}
 
 		// check if 2fa is enabled
-		status, err := action.TwoFAStatus(c)
+		status, err := action.TwoFAStatus(context.Background(), c)
 		if err != nil {
 			t.Fatalf("Failed to get 2fa status: %s", err)
 		}
@@ -485,7 +492,7 @@ func Test2fa(t *testing.T) {
 		}
 
 		// disable 2fa
-		if err := action.Disable2FA(c, password); err != nil {
+		if err := action.Disable2FA(context.Background(), c, password); err != nil {
 			t.Fatalf("Failed to disable 2fa: %s", err)
 		}
 	})
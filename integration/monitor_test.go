@@ -3,6 +3,7 @@
 package integration_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -36,11 +37,14 @@ func TestMonitors(t *testing.T) {
 	defer c.Close()
 
 	t.Run("Trigger get monitor list event, wait for it; should be empty", func(t *testing.T) {
-		if err := action.GetMonitorList(c); err != nil {
+		if err := action.GetMonitorList(context.Background(), c); err != nil {
 			t.Fatalf("Failed to get monitor list: %s", err)
 		}
 
-		if err := c.Await(handler.MonitorListEvent, time.Duration(3)*time.Second); err != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(3)*time.Second)
+		defer cancel()
+
+		if err := c.Await(ctx, handler.MonitorListEvent); err != nil {
 			t.Fatalf("Failed to await monitor list event: %s", err)
 		}
 
@@ -67,7 +71,7 @@ func TestMonitors(t *testing.T) {
 			Method:        utils.NewString("GET"),
 		}
 
-		id, err := action.AddMonitor(c, monitor)
+		id, err := action.AddMonitor(context.Background(), c, monitor)
 		if err != nil {
 			t.Fatalf("Failed to add monitor: %s", err)
 		}
@@ -3,6 +3,7 @@
 package integration_test
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -53,7 +54,7 @@ func TestTags(t *testing.T) {
 
 	t.Run("Get tags, should be empty", func(t *testing.T) {
 		// get tags
-		tags, err := action.GetTags(c)
+		tags, err := action.GetTags(context.Background(), c)
 		if err != nil {
 			t.Fatalf("Failed to get tags: %s", err)
 		}
@@ -66,7 +67,7 @@ func TestTags(t *testing.T) {
 
 	t.Run("Add first tag", func(t *testing.T) {
 		// add tag
-		tag, err := action.AddTag(c, wantTags[0].Name, wantTags[0].Color)
+		tag, err := action.AddTag(context.Background(), c, wantTags[0].Name, wantTags[0].Color)
 		if err != nil {
 			t.Fatalf("Failed to add tag: %s", err)
 		}
@@ -81,7 +82,7 @@ func TestTags(t *testing.T) {
 
 	t.Run("Get list of tags, should be 1", func(t *testing.T) {
 		// get tags
-		tags, err := action.GetTags(c)
+		tags, err := action.GetTags(context.Background(), c)
 		if err != nil {
 			t.Fatalf("Failed to get tags: %s", err)
 		}
@@ -96,7 +97,7 @@ func TestTags(t *testing.T) {
 
 	t.Run("Add second tag", func(t *testing.T) {
 		// add tag
-		tag, err := action.AddTag(c, wantTags[1].Name, wantTags[1].Color)
+		tag, err := action.AddTag(context.Background(), c, wantTags[1].Name, wantTags[1].Color)
 		if err != nil {
 			t.Fatalf("Failed to add tag: %s", err)
 		}
@@ -111,7 +112,7 @@ func TestTags(t *testing.T) {
 
 	t.Run("Get list of tags, should be 2", func(t *testing.T) {
 		// get tags
-		tags, err := action.GetTags(c)
+		tags, err := action.GetTags(context.Background(), c)
 		if err != nil {
 			t.Fatalf("Failed to get tags: %s", err)
 		}
@@ -126,7 +127,7 @@ func TestTags(t *testing.T) {
 
 	t.Run("Edit first tag", func(t *testing.T) {
 		// edit tag
-		tag, err := action.EditTag(c, modifiedTag.Id, modifiedTag.Name, modifiedTag.Color)
+		tag, err := action.EditTag(context.Background(), c, modifiedTag.Id, modifiedTag.Name, modifiedTag.Color)
 		if err != nil {
 			t.Fatalf("Failed to edit tag: %s", err)
 		}
@@ -141,7 +142,7 @@ func TestTags(t *testing.T) {
 
 	t.Run("Delete first tag", func(t *testing.T) {
 		// delete tag
-		err := action.DeleteTag(c, wantTags[0].Id)
+		err := action.DeleteTag(context.Background(), c, wantTags[0].Id)
 		if err != nil {
 			t.Fatalf("Failed to delete tag: %s", err)
 		}
@@ -149,7 +150,7 @@ func TestTags(t *testing.T) {
 
 	t.Run("Get list of tags, should be 1", func(t *testing.T) {
 		// get tags
-		tags, err := action.GetTags(c)
+		tags, err := action.GetTags(context.Background(), c)
 		if err != nil {
 			t.Fatalf("Failed to get tags: %s", err)
 		}
@@ -119,6 +119,59 @@ func (_c *Connection_Close_Call) RunAndReturn(run func()) *Connection_Close_Call
 	return _c
 }
 
+// Emit provides a mock function with given fields: _a0, _a1
+func (_m *Connection) Emit(_a0 string, _a1 ...interface{}) error {
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, _a1...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) error); ok {
+		r0 = rf(_a0, _a1...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Connection_Emit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Emit'
+type Connection_Emit_Call struct {
+	*mock.Call
+}
+
+// Emit is a helper method to define mock.On call
+//   - _a0 string
+//   - _a1 ...interface{}
+func (_e *Connection_Expecter) Emit(_a0 interface{}, _a1 ...interface{}) *Connection_Emit_Call {
+	return &Connection_Emit_Call{Call: _e.mock.On("Emit",
+		append([]interface{}{_a0}, _a1...)...)}
+}
+
+func (_c *Connection_Emit_Call) Run(run func(_a0 string, _a1 ...interface{})) *Connection_Emit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Connection_Emit_Call) Return(_a0 error) *Connection_Emit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Connection_Emit_Call) RunAndReturn(run func(string, ...interface{}) error) *Connection_Emit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // On provides a mock function with given fields: _a0, _a1
 func (_m *Connection) On(_a0 string, _a1 interface{}) error {
 	ret := _m.Called(_a0, _a1)
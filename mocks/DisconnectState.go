@@ -17,6 +17,81 @@ func (_m *DisconnectState) EXPECT() *DisconnectState_Expecter {
 	return &DisconnectState_Expecter{mock: &_m.Mock}
 }
 
+// HasSeen provides a mock function with given fields: event
+func (_m *DisconnectState) HasSeen(event string) bool {
+	ret := _m.Called(event)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(event)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// DisconnectState_HasSeen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasSeen'
+type DisconnectState_HasSeen_Call struct {
+	*mock.Call
+}
+
+// HasSeen is a helper method to define mock.On call
+//   - event string
+func (_e *DisconnectState_Expecter) HasSeen(event interface{}) *DisconnectState_HasSeen_Call {
+	return &DisconnectState_HasSeen_Call{Call: _e.mock.On("HasSeen", event)}
+}
+
+func (_c *DisconnectState_HasSeen_Call) Run(run func(event string)) *DisconnectState_HasSeen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DisconnectState_HasSeen_Call) Return(seen bool) *DisconnectState_HasSeen_Call {
+	_c.Call.Return(seen)
+	return _c
+}
+
+func (_c *DisconnectState_HasSeen_Call) RunAndReturn(run func(string) bool) *DisconnectState_HasSeen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSeen provides a mock function with given fields: event
+func (_m *DisconnectState) MarkSeen(event string) {
+	_m.Called(event)
+}
+
+// DisconnectState_MarkSeen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSeen'
+type DisconnectState_MarkSeen_Call struct {
+	*mock.Call
+}
+
+// MarkSeen is a helper method to define mock.On call
+//   - event string
+func (_e *DisconnectState_Expecter) MarkSeen(event interface{}) *DisconnectState_MarkSeen_Call {
+	return &DisconnectState_MarkSeen_Call{Call: _e.mock.On("MarkSeen", event)}
+}
+
+func (_c *DisconnectState_MarkSeen_Call) Run(run func(event string)) *DisconnectState_MarkSeen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *DisconnectState_MarkSeen_Call) Return() *DisconnectState_MarkSeen_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *DisconnectState_MarkSeen_Call) RunAndReturn(run func(string)) *DisconnectState_MarkSeen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetConnected provides a mock function with given fields: _a0
 func (_m *DisconnectState) SetConnected(_a0 bool) error {
 	ret := _m.Called(_a0)
@@ -3,10 +3,10 @@
 package mocks
 
 import (
+	context "context"
+
 	state "github.com/nobbs/uptime-kuma-api/pkg/state"
 	mock "github.com/stretchr/testify/mock"
-
-	time "time"
 )
 
 // StatefulEmiter is an autogenerated mock type for the StatefulEmiter type
@@ -23,11 +23,11 @@ func (_m *StatefulEmiter) EXPECT() *StatefulEmiter_Expecter {
 }
 
 // Await provides a mock function with given fields: _a0, _a1
-func (_m *StatefulEmiter) Await(_a0 string, _a1 time.Duration) error {
+func (_m *StatefulEmiter) Await(_a0 context.Context, _a1 string) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, time.Duration) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -42,15 +42,15 @@ type StatefulEmiter_Await_Call struct {
 }
 
 // Await is a helper method to define mock.On call
-//   - _a0 string
-//   - _a1 time.Duration
+//   - _a0 context.Context
+//   - _a1 string
 func (_e *StatefulEmiter_Expecter) Await(_a0 interface{}, _a1 interface{}) *StatefulEmiter_Await_Call {
 	return &StatefulEmiter_Await_Call{Call: _e.mock.On("Await", _a0, _a1)}
 }
 
-func (_c *StatefulEmiter_Await_Call) Run(run func(_a0 string, _a1 time.Duration)) *StatefulEmiter_Await_Call {
+func (_c *StatefulEmiter_Await_Call) Run(run func(_a0 context.Context, _a1 string)) *StatefulEmiter_Await_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(time.Duration))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -60,13 +60,13 @@ func (_c *StatefulEmiter_Await_Call) Return(_a0 error) *StatefulEmiter_Await_Cal
 	return _c
 }
 
-func (_c *StatefulEmiter_Await_Call) RunAndReturn(run func(string, time.Duration) error) *StatefulEmiter_Await_Call {
+func (_c *StatefulEmiter_Await_Call) RunAndReturn(run func(context.Context, string) error) *StatefulEmiter_Await_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Emit provides a mock function with given fields: _a0, _a1, _a2
-func (_m *StatefulEmiter) Emit(_a0 string, _a1 time.Duration, _a2 ...interface{}) (interface{}, error) {
+func (_m *StatefulEmiter) Emit(_a0 context.Context, _a1 string, _a2 ...interface{}) (interface{}, error) {
 	var _ca []interface{}
 	_ca = append(_ca, _a0, _a1)
 	_ca = append(_ca, _a2...)
@@ -74,10 +74,10 @@ func (_m *StatefulEmiter) Emit(_a0 string, _a1 time.Duration, _a2 ...interface{}
 
 	var r0 interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, time.Duration, ...interface{}) (interface{}, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) (interface{}, error)); ok {
 		return rf(_a0, _a1, _a2...)
 	}
-	if rf, ok := ret.Get(0).(func(string, time.Duration, ...interface{}) interface{}); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) interface{}); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
@@ -85,7 +85,7 @@ func (_m *StatefulEmiter) Emit(_a0 string, _a1 time.Duration, _a2 ...interface{}
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, time.Duration, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...interface{}) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -100,15 +100,15 @@ type StatefulEmiter_Emit_Call struct {
 }
 
 // Emit is a helper method to define mock.On call
-//   - _a0 string
-//   - _a1 time.Duration
+//   - _a0 context.Context
+//   - _a1 string
 //   - _a2 ...interface{}
 func (_e *StatefulEmiter_Expecter) Emit(_a0 interface{}, _a1 interface{}, _a2 ...interface{}) *StatefulEmiter_Emit_Call {
 	return &StatefulEmiter_Emit_Call{Call: _e.mock.On("Emit",
 		append([]interface{}{_a0, _a1}, _a2...)...)}
 }
 
-func (_c *StatefulEmiter_Emit_Call) Run(run func(_a0 string, _a1 time.Duration, _a2 ...interface{})) *StatefulEmiter_Emit_Call {
+func (_c *StatefulEmiter_Emit_Call) Run(run func(_a0 context.Context, _a1 string, _a2 ...interface{})) *StatefulEmiter_Emit_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]interface{}, len(args)-2)
 		for i, a := range args[2:] {
@@ -116,7 +116,7 @@ func (_c *StatefulEmiter_Emit_Call) Run(run func(_a0 string, _a1 time.Duration,
 				variadicArgs[i] = a.(interface{})
 			}
 		}
-		run(args[0].(string), args[1].(time.Duration), variadicArgs...)
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
@@ -126,7 +126,61 @@ func (_c *StatefulEmiter_Emit_Call) Return(_a0 interface{}, _a1 error) *Stateful
 	return _c
 }
 
-func (_c *StatefulEmiter_Emit_Call) RunAndReturn(run func(string, time.Duration, ...interface{}) (interface{}, error)) *StatefulEmiter_Emit_Call {
+func (_c *StatefulEmiter_Emit_Call) RunAndReturn(run func(context.Context, string, ...interface{}) (interface{}, error)) *StatefulEmiter_Emit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Send provides a mock function with given fields: _a0, _a1, _a2
+func (_m *StatefulEmiter) Send(_a0 context.Context, _a1 string, _a2 ...interface{}) error {
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _a2...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatefulEmiter_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type StatefulEmiter_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 string
+//   - _a2 ...interface{}
+func (_e *StatefulEmiter_Expecter) Send(_a0 interface{}, _a1 interface{}, _a2 ...interface{}) *StatefulEmiter_Send_Call {
+	return &StatefulEmiter_Send_Call{Call: _e.mock.On("Send",
+		append([]interface{}{_a0, _a1}, _a2...)...)}
+}
+
+func (_c *StatefulEmiter_Send_Call) Run(run func(_a0 context.Context, _a1 string, _a2 ...interface{})) *StatefulEmiter_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *StatefulEmiter_Send_Call) Return(_a0 error) *StatefulEmiter_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatefulEmiter_Send_Call) RunAndReturn(run func(context.Context, string, ...interface{}) error) *StatefulEmiter_Send_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1,34 +1,50 @@
 package action
 
 import (
+	"context"
 	"encoding/json"
-	"time"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/nobbs/uptime-kuma-api/pkg/state"
 )
 
-const (
-	// defaultEmitTimeout is the default timeout for emitted events.
-	defaultEmitTimeout = time.Duration(5) * time.Second
-
-	// defaultAwaitTimeout is the default timeout for awaited events.
-	defaultAwaitTimeout = time.Duration(5) * time.Second
-)
-
 // StatefulEmiter is the interface that provides the basic client methods required by the actions.
+// All actions pass their context on to the client, so that deadlines and cancellation of the caller
+// apply to both awaiting events and emitting the action itself.
 type StatefulEmiter interface {
-	// Emit sends an event with the given data to the server and waits for an acknowledgement.
-	Emit(string, time.Duration, ...any) (any, error)
+	// Emit sends an event with the given data to the server and waits for an acknowledgement until
+	// the context is done.
+	Emit(context.Context, string, ...any) (any, error)
 
-	// Await waits for the first event with the given name to be received. If the timeout is reached
+	// Send sends an event with the given data to the server without waiting for an
+	// acknowledgement, for the events the server does not acknowledge.
+	Send(context.Context, string, ...any) error
+
+	// Await waits for the first event with the given name to be received. If the context is done
 	// before the event is received, an error is returned.
-	Await(string, time.Duration) error
+	Await(context.Context, string) error
 
 	// State returns the current state of the client.
 	State() *state.State
 }
 
+// Status is the status every action response carries.
+type Status struct {
+	Ok      bool    `mapstructure:"ok"`
+	Msg     *string `mapstructure:"msg"`
+	MsgI18n bool    `mapstructure:"msgi18n"`
+}
+
+// DecodeStatus decodes the status of a raw action response, e.g. for logging or metrics.
+func DecodeStatus(raw any) (Status, error) {
+	status := Status{}
+	if err := decode(raw, &status); err != nil {
+		return Status{}, err
+	}
+
+	return status, nil
+}
+
 // decode decodes the raw action response into the given result struct.
 func decode(raw any, result any) error {
 	// assert that result is a slice of interfaces, that we only have one element
@@ -0,0 +1,173 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+const (
+	addAPIKeyAction     = "addAPIKey"
+	getAPIKeyListAction = "getAPIKeyList"
+	enableAPIKeyAction  = "enableAPIKey"
+	disableAPIKeyAction = "disableAPIKey"
+	deleteAPIKeyAction  = "deleteAPIKey"
+)
+
+// APIKey is the configuration of an API key as sent with the addAPIKey action.
+type APIKey struct {
+	Name string
+
+	// Expires is the expiry date of the key, nil if it never expires. It is sent as wall clock
+	// time in its location, AddAPIKey converts it into the timezone of the server, as the server
+	// compares it with its local time.
+	Expires *time.Time
+
+	Active bool
+}
+
+// MarshalJSON encodes the API key as expected by Uptime Kuma.
+func (k APIKey) MarshalJSON() ([]byte, error) {
+	var expires *string
+	if k.Expires != nil {
+		expires = utils.NewString(k.Expires.Format(utils.TimeLayout))
+	}
+
+	return json.Marshal(struct {
+		Name    string  `json:"name"`
+		Expires *string `json:"expires"`
+		Active  bool    `json:"active"`
+	}{Name: k.Name, Expires: expires, Active: k.Active})
+}
+
+type addAPIKeyResponse struct {
+	Ok       bool    `mapstructure:"ok"`
+	Msg      *string `mapstructure:"msg"`
+	Key      *string `mapstructure:"key"`
+	APIKeyId *int    `mapstructure:"keyID"`
+}
+
+type apiKeyStatusResponse struct {
+	Ok  bool    `mapstructure:"ok"`
+	Msg *string `mapstructure:"msg"`
+}
+
+// AddAPIKey adds a new API key and returns its id and the key. The key is only returned once and
+// can't be retrieved later.
+func AddAPIKey(ctx context.Context, c StatefulEmiter, apiKey *APIKey) (int, string, error) {
+	if apiKey == nil || apiKey.Name == "" {
+		return 0, "", wrapErrActionFailed(addAPIKeyAction, fmt.Errorf("%w: api key name is required", ErrValidation))
+	}
+
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return 0, "", wrapErrActionFailed(addAPIKeyAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// the server compares the expiry date with its local time
+	if apiKey.Expires != nil {
+		expires := apiKey.Expires.In(serverLocation(c))
+		apiKey = &APIKey{Name: apiKey.Name, Expires: &expires, Active: apiKey.Active}
+	}
+
+	// call action
+	response, err := c.Emit(ctx, addAPIKeyAction, apiKey)
+	if err != nil {
+		return 0, "", wrapErrActionFailed(addAPIKeyAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &addAPIKeyResponse{}
+	if err := decode(response, data); err != nil {
+		return 0, "", wrapErrActionFailed(addAPIKeyAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return 0, "", newErrResponse(addAPIKeyAction, response)
+	}
+
+	// check if key and key id are present
+	if data.Key == nil || data.APIKeyId == nil {
+		return 0, "", wrapErrActionFailed(addAPIKeyAction, ErrInvalidResponse)
+	}
+
+	return *data.APIKeyId, *data.Key, nil
+}
+
+// serverLocation returns the timezone of the server as reported by the info event, UTC if it is
+// unknown.
+func serverLocation(c StatefulEmiter) *time.Location {
+	info, err := c.State().Info()
+	if err != nil {
+		return time.UTC
+	}
+
+	if info.ServerTimezone != nil {
+		if location, err := time.LoadLocation(*info.ServerTimezone); err == nil {
+			return location
+		}
+	}
+
+	// the offset is formatted like +02:00
+	if info.ServerTimezoneOffset != nil {
+		if t, err := time.Parse("-07:00", *info.ServerTimezoneOffset); err == nil {
+			_, offset := t.Zone()
+			return time.FixedZone(*info.ServerTimezoneOffset, offset)
+		}
+	}
+
+	return time.UTC
+}
+
+// GetAPIKeyList triggers the server to emit the API key list event, which is handled by the
+// apiKeyList event handler.
+func GetAPIKeyList(ctx context.Context, c StatefulEmiter) error {
+	return callAPIKeyAction(ctx, c, getAPIKeyListAction)
+}
+
+// EnableAPIKey enables the API key with the given id.
+func EnableAPIKey(ctx context.Context, c StatefulEmiter, apiKeyId int) error {
+	return callAPIKeyAction(ctx, c, enableAPIKeyAction, apiKeyId)
+}
+
+// DisableAPIKey disables the API key with the given id, it can't be used until enabled again.
+func DisableAPIKey(ctx context.Context, c StatefulEmiter, apiKeyId int) error {
+	return callAPIKeyAction(ctx, c, disableAPIKeyAction, apiKeyId)
+}
+
+// DeleteAPIKey deletes the API key with the given id.
+func DeleteAPIKey(ctx context.Context, c StatefulEmiter, apiKeyId int) error {
+	return callAPIKeyAction(ctx, c, deleteAPIKeyAction, apiKeyId)
+}
+
+// callAPIKeyAction calls an API key action that only returns a status.
+func callAPIKeyAction(ctx context.Context, c StatefulEmiter, action string, args ...any) error {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(action, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, action, args...)
+	if err != nil {
+		return wrapErrActionFailed(action, err)
+	}
+
+	// unmarshal raw response data
+	data := &apiKeyStatusResponse{}
+	if err := decode(response, data); err != nil {
+		return wrapErrActionFailed(action, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return newErrResponse(action, response)
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAPIKey_MarshalJSON(t *testing.T) {
+	expires := time.Date(2024, 3, 1, 14, 30, 0, 0, time.FixedZone("CET", 3600))
+
+	tests := []struct {
+		name   string
+		apiKey APIKey
+		want   string
+	}{
+		{
+			name:   "never expires",
+			apiKey: APIKey{Name: "ci", Active: true},
+			want:   `{"name":"ci","expires":null,"active":true}`,
+		},
+		{
+			name:   "expires in its location",
+			apiKey: APIKey{Name: "ci", Expires: &expires},
+			want:   `{"name":"ci","expires":"2024-03-01 14:30:00","active":false}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.apiKey)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestAddAPIKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		apiKey   *APIKey
+		response any
+		wantId   int
+		wantKey  string
+		wantErr  error
+	}{
+		{
+			name:     "ok",
+			apiKey:   &APIKey{Name: "ci", Active: true},
+			response: []any{[]byte(`{"ok":true,"msg":"Added Successfully.","key":"uk1_abc","keyID":3}`)},
+			wantId:   3,
+			wantKey:  "uk1_abc",
+		},
+		{
+			name:     "missing key",
+			apiKey:   &APIKey{Name: "ci", Active: true},
+			response: []any{[]byte(`{"ok":true,"keyID":3}`)},
+			wantErr:  ErrInvalidResponse,
+		},
+		{
+			name:     "missing key id",
+			apiKey:   &APIKey{Name: "ci", Active: true},
+			response: []any{[]byte(`{"ok":true,"key":"uk1_abc"}`)},
+			wantErr:  ErrInvalidResponse,
+		},
+		{
+			name:     "not ok",
+			apiKey:   &APIKey{Name: "ci", Active: true},
+			response: []any{[]byte(`{"ok":false,"msg":"You are not logged in."}`)},
+			wantErr:  ErrNotLoggedIn,
+		},
+		{
+			name:    "nil api key",
+			wantErr: ErrValidation,
+		},
+		{
+			name:    "missing name",
+			apiKey:  &APIKey{Active: true},
+			wantErr: ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := mocks.NewStatefulEmiter(t)
+
+			if tt.response != nil {
+				c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(nil).Once()
+				c.EXPECT().Emit(mock.Anything, addAPIKeyAction, tt.apiKey).Return(tt.response, nil).Once()
+			}
+
+			id, key, err := AddAPIKey(context.Background(), c, tt.apiKey)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantId, id)
+			assert.Equal(t, tt.wantKey, key)
+		})
+	}
+}
+
+func TestAddAPIKey_Expires(t *testing.T) {
+	expires := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		info        *state.Info
+		wantExpires string
+	}{
+		{
+			name:        "unknown timezone",
+			wantExpires: "2024-03-01 12:00:00",
+		},
+		{
+			name:        "server timezone",
+			info:        &state.Info{ServerTimezone: utils.NewString("America/New_York"), ServerTimezoneOffset: utils.NewString("-05:00")},
+			wantExpires: "2024-03-01 07:00:00",
+		},
+		{
+			name:        "server timezone offset",
+			info:        &state.Info{ServerTimezone: utils.NewString("Invalid/Zone"), ServerTimezoneOffset: utils.NewString("+05:30")},
+			wantExpires: "2024-03-01 17:30:00",
+		},
+		{
+			name:        "utc server",
+			info:        &state.Info{ServerTimezone: utils.NewString("UTC"), ServerTimezoneOffset: utils.NewString("+00:00")},
+			wantExpires: "2024-03-01 12:00:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := state.NewState()
+			if tt.info != nil {
+				assert.NoError(t, s.SetInfo(tt.info))
+			}
+
+			apiKey := &APIKey{Name: "ci", Expires: &expires, Active: true}
+			want := `{"name":"ci","expires":"` + tt.wantExpires + `","active":true}`
+
+			c := mocks.NewStatefulEmiter(t)
+			c.EXPECT().State().Return(s)
+			c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(nil).Once()
+			c.EXPECT().Emit(mock.Anything, addAPIKeyAction, mock.MatchedBy(func(k *APIKey) bool {
+				got, err := json.Marshal(k)
+				return err == nil && string(got) == want
+			})).Return([]any{[]byte(`{"ok":true,"key":"uk1_abc","keyID":3}`)}, nil).Once()
+
+			_, _, err := AddAPIKey(context.Background(), c, apiKey)
+			assert.NoError(t, err)
+
+			// the given api key is not modified
+			assert.Equal(t, &expires, apiKey.Expires)
+		})
+	}
+}
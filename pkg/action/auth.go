@@ -1,6 +1,7 @@
 package action
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/nobbs/uptime-kuma-api/pkg/handler"
@@ -90,16 +91,16 @@ type setupResponse struct {
 // Login sends a login request to the server with the provided username, password, and token. If the
 // username and password are empty, it checks if auto login is enabled and returns an empty
 // response. Returns a token and nil error if login is successful, otherwise returns an error.
-func Login(c StatefulEmiter, username, password, token string) (string, error) {
+func Login(ctx context.Context, c StatefulEmiter, username, password, token string) (string, error) {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return "", NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return "", wrapErrActionFailed(loginAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// if username and password are empty, check if auto login is enabled
 	if username == "" && password == "" {
-		if err := c.Await(handler.AutoLoginEvent, defaultAwaitTimeout); err != nil {
-			return "", NewErrAwaitFailed(handler.AutoLoginEvent, err)
+		if err := c.Await(ctx, handler.AutoLoginEvent); err != nil {
+			return "", wrapErrActionFailed(loginAction, NewErrAwaitFailed(handler.AutoLoginEvent, err))
 		}
 
 		// auto login is enabled, return empty response
@@ -113,30 +114,40 @@ func Login(c StatefulEmiter, username, password, token string) (string, error) {
 		Token:    token,
 	}
 
-	response, err := c.Emit(loginAction, defaultEmitTimeout, request)
+	response, err := c.Emit(ctx, loginAction, request)
 	if err != nil {
-		return "", NewErrActionFailed(loginAction, err.Error())
+		return "", wrapErrActionFailed(loginAction, err)
 	}
 
 	// unmarshal data
 	data := &loginResponse{}
 	if err := decode(response, data); err != nil {
-		return "", NewErrActionFailed(loginAction, err.Error())
+		return "", wrapErrActionFailed(loginAction, err)
 	}
 
 	// check if token is required
 	if data.TokenRequired != nil && *data.TokenRequired {
-		return "", Err2faTokenRequired
+		return "", wrapErrActionFailed(loginAction, Err2faTokenRequired)
 	}
 
 	// check if login was successful
 	if !data.Ok {
-		return "", NewErrActionFailed(loginAction, *data.Msg)
+		return "", newErrResponse(loginAction, response)
+	}
+
+	// check if token is present
+	if data.Token == nil {
+		return "", wrapErrActionFailed(loginAction, ErrInvalidResponse)
 	}
 
 	// set logged in to true
 	if err := c.State().SetLoggedIn(true); err != nil {
-		return "", err
+		return "", wrapErrActionFailed(loginAction, err)
+	}
+
+	// remember token to be able to login again after a reconnect
+	if err := c.State().SetToken(*data.Token); err != nil {
+		return "", wrapErrActionFailed(loginAction, err)
 	}
 
 	return *data.Token, nil
@@ -146,61 +157,71 @@ func Login(c StatefulEmiter, username, password, token string) (string, error) {
 // by token request, decodes the response into a struct, checks if the login was successful, and
 // sets the client's logged in status to true if successful. Returns an error if any of the steps
 // fail.
-func LoginByToken(c StatefulEmiter, token string) error {
+func LoginByToken(ctx context.Context, c StatefulEmiter, token string) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(loginByTokenAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// send login by token request
-	response, err := c.Emit(loginByTokenAction, defaultEmitTimeout, token)
+	response, err := c.Emit(ctx, loginByTokenAction, token)
 	if err != nil {
-		return NewErrActionFailed(loginByTokenAction, err.Error())
+		return wrapErrActionFailed(loginByTokenAction, err)
 	}
 
 	// decode response into struct
 	data := &loginResponse{}
 	if err := decode(response, data); err != nil {
-		return NewErrActionFailed(loginByTokenAction, err.Error())
+		return wrapErrActionFailed(loginByTokenAction, err)
 	}
 
 	// check if login was successful
 	if !data.Ok {
-		return NewErrLoginFailed(*data.Msg)
+		return newErrResponse(loginByTokenAction, response)
 	}
 
 	// set logged in to true
 	if err := c.State().SetLoggedIn(true); err != nil {
-		return err
+		return wrapErrActionFailed(loginByTokenAction, err)
+	}
+
+	// remember token to be able to login again after a reconnect
+	if err := c.State().SetToken(token); err != nil {
+		return wrapErrActionFailed(loginByTokenAction, err)
 	}
 
 	return nil
 }
 
 // Log out the current user.
-func Logout(c StatefulEmiter) error {
+func Logout(ctx context.Context, c StatefulEmiter) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(logoutAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// send logout request to server, ignore response
-	if _, err := c.Emit(logoutAction, defaultEmitTimeout); err != nil {
-		return fmt.Errorf("%s: %w", logoutAction, err)
+	if _, err := c.Emit(ctx, logoutAction); err != nil {
+		return wrapErrActionFailed(logoutAction, err)
 	}
 
 	// set logged in to false
 	if err := c.State().SetLoggedIn(false); err != nil {
-		return fmt.Errorf("set logged in: %w", err)
+		return wrapErrActionFailed(logoutAction, fmt.Errorf("set logged in: %w", err))
+	}
+
+	// forget token, there is nothing to replay after a reconnect anymore
+	if err := c.State().SetToken(""); err != nil {
+		return wrapErrActionFailed(logoutAction, fmt.Errorf("set token: %w", err))
 	}
 
 	return nil
 }
 
-func ChangePassword(c StatefulEmiter, currentPassword, newPassword string) error {
+func ChangePassword(ctx context.Context, c StatefulEmiter, currentPassword, newPassword string) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(changePasswordAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	request := &changePasswordRequest{
@@ -209,20 +230,20 @@ func ChangePassword(c StatefulEmiter, currentPassword, newPassword string) error
 	}
 
 	// call action
-	reseponse, err := c.Emit(changePasswordAction, defaultEmitTimeout, request)
+	response, err := c.Emit(ctx, changePasswordAction, request)
 	if err != nil {
-		return NewErrActionFailed(changePasswordAction, err.Error())
+		return wrapErrActionFailed(changePasswordAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &changePasswordResponse{}
-	if err := decode(reseponse, data); err != nil {
-		return NewErrActionFailed(changePasswordAction, err.Error())
+	if err := decode(response, data); err != nil {
+		return wrapErrActionFailed(changePasswordAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return NewErrActionFailed(changePasswordAction, *data.Msg)
+		return newErrResponse(changePasswordAction, response)
 	}
 
 	return nil
@@ -230,27 +251,32 @@ func ChangePassword(c StatefulEmiter, currentPassword, newPassword string) error
 
 // Prepare2FA prepares the client for 2fa by sending a request to the server and returning the
 // Uri required to generate TOTP codes. Returns an error if the request fails.
-func Prepare2FA(c StatefulEmiter, currentPassword string) (string, error) {
+func Prepare2FA(ctx context.Context, c StatefulEmiter, currentPassword string) (string, error) {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return "", NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return "", wrapErrActionFailed(prepare2faAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(prepare2faAction, defaultEmitTimeout, currentPassword)
+	response, err := c.Emit(ctx, prepare2faAction, currentPassword)
 	if err != nil {
-		return "", NewErrActionFailed(prepare2faAction, err.Error())
+		return "", wrapErrActionFailed(prepare2faAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &prepare2faResponse{}
 	if err := decode(response, data); err != nil {
-		return "", NewErrActionFailed(prepare2faAction, err.Error())
+		return "", wrapErrActionFailed(prepare2faAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return "", NewErrActionFailed(prepare2faAction, *data.Msg)
+		return "", newErrResponse(prepare2faAction, response)
+	}
+
+	// check if uri is present
+	if data.Uri == nil {
+		return "", wrapErrActionFailed(prepare2faAction, ErrInvalidResponse)
 	}
 
 	return *data.Uri, nil
@@ -258,108 +284,118 @@ func Prepare2FA(c StatefulEmiter, currentPassword string) (string, error) {
 
 // Save2FA saves the 2fa Uri to the server thus enabling 2fa for the client. Returns an error if the
 // request fails.
-func Save2FA(c StatefulEmiter, currentPassword string) error {
+func Save2FA(ctx context.Context, c StatefulEmiter, currentPassword string) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(save2faAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(save2faAction, defaultEmitTimeout, currentPassword)
+	response, err := c.Emit(ctx, save2faAction, currentPassword)
 	if err != nil {
-		return NewErrActionFailed(save2faAction, err.Error())
+		return wrapErrActionFailed(save2faAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &save2faResponse{}
 	if err := decode(response, data); err != nil {
-		return NewErrActionFailed(save2faAction, err.Error())
+		return wrapErrActionFailed(save2faAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return NewErrActionFailed(save2faAction, *data.Msg)
+		return newErrResponse(save2faAction, response)
 	}
 
 	return nil
 }
 
 // Disable2FA disables 2fa for the client. Returns an error if the request fails.
-func Disable2FA(c StatefulEmiter, currentPassword string) error {
+func Disable2FA(ctx context.Context, c StatefulEmiter, currentPassword string) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(disable2faAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(disable2faAction, defaultEmitTimeout, currentPassword)
+	response, err := c.Emit(ctx, disable2faAction, currentPassword)
 	if err != nil {
-		return NewErrActionFailed(disable2faAction, err.Error())
+		return wrapErrActionFailed(disable2faAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &disable2faResponse{}
 	if err := decode(response, data); err != nil {
-		return NewErrActionFailed(disable2faAction, err.Error())
+		return wrapErrActionFailed(disable2faAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return NewErrActionFailed(disable2faAction, *data.Msg)
+		return newErrResponse(disable2faAction, response)
 	}
 
 	return nil
 }
 
 // VerifyToken verifies the provided 2fa token. Returns an error if the request fails.
-func VerifyToken(c StatefulEmiter, currentPassword, token string) (bool, error) {
+func VerifyToken(ctx context.Context, c StatefulEmiter, currentPassword, token string) (bool, error) {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return false, NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return false, wrapErrActionFailed(verifyTokenAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(verifyTokenAction, defaultEmitTimeout, token, currentPassword)
+	response, err := c.Emit(ctx, verifyTokenAction, token, currentPassword)
 	if err != nil {
-		return false, NewErrActionFailed(verifyTokenAction, err.Error())
+		return false, wrapErrActionFailed(verifyTokenAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &verifyTokenResponse{}
 	if err := decode(response, data); err != nil {
-		return false, NewErrActionFailed(verifyTokenAction, err.Error())
+		return false, wrapErrActionFailed(verifyTokenAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return false, NewErrActionFailed(verifyTokenAction, *data.Msg)
+		return false, newErrResponse(verifyTokenAction, response)
+	}
+
+	// check if valid flag is present
+	if data.Valid == nil {
+		return false, wrapErrActionFailed(verifyTokenAction, ErrInvalidResponse)
 	}
 
 	return *data.Valid, nil
 }
 
 // TwoFAStatus returns the current 2fa status. Returns an error if the request fails.
-func TwoFAStatus(c StatefulEmiter) (bool, error) {
+func TwoFAStatus(ctx context.Context, c StatefulEmiter) (bool, error) {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return false, NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return false, wrapErrActionFailed(twoFAStatusAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(twoFAStatusAction, defaultEmitTimeout)
+	response, err := c.Emit(ctx, twoFAStatusAction)
 	if err != nil {
-		return false, NewErrActionFailed(twoFAStatusAction, err.Error())
+		return false, wrapErrActionFailed(twoFAStatusAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &twoFAStatusResponse{}
 	if err := decode(response, data); err != nil {
-		return false, NewErrActionFailed(twoFAStatusAction, err.Error())
+		return false, wrapErrActionFailed(twoFAStatusAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return false, NewErrActionFailed(twoFAStatusAction, *data.Msg)
+		return false, newErrResponse(twoFAStatusAction, response)
+	}
+
+	// check if status is present
+	if data.Status == nil {
+		return false, wrapErrActionFailed(twoFAStatusAction, ErrInvalidResponse)
 	}
 
 	return *data.Status, nil
@@ -367,22 +403,22 @@ func TwoFAStatus(c StatefulEmiter) (bool, error) {
 
 // NeedSetup returns true if the server needs to be setup, otherwise returns false. Returns an
 // error if the request fails.
-func NeedSetup(c StatefulEmiter) (bool, error) {
+func NeedSetup(ctx context.Context, c StatefulEmiter) (bool, error) {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return false, NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return false, wrapErrActionFailed(needSetupAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(needSetupAction, defaultEmitTimeout)
+	response, err := c.Emit(ctx, needSetupAction)
 	if err != nil {
-		return false, NewErrActionFailed(needSetupAction, err.Error())
+		return false, wrapErrActionFailed(needSetupAction, err)
 	}
 
 	// unmarshal raw response data
 	data := utils.NewBool(false)
 	if err := decode(response, data); err != nil {
-		return false, NewErrActionFailed(needSetupAction, err.Error())
+		return false, wrapErrActionFailed(needSetupAction, err)
 	}
 
 	return *data, nil
@@ -390,27 +426,27 @@ func NeedSetup(c StatefulEmiter) (bool, error) {
 
 // Setup sets up the server with the provided username and password. Returns an error if the request
 // fails.
-func Setup(c StatefulEmiter, username, password string) error {
+func Setup(ctx context.Context, c StatefulEmiter, username, password string) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(setupAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(setupAction, defaultEmitTimeout, username, password)
+	response, err := c.Emit(ctx, setupAction, username, password)
 	if err != nil {
-		return NewErrActionFailed(setupAction, err.Error())
+		return wrapErrActionFailed(setupAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &setupResponse{}
 	if err := decode(response, data); err != nil {
-		return NewErrActionFailed(setupAction, err.Error())
+		return wrapErrActionFailed(setupAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return NewErrActionFailed(setupAction, *data.Msg)
+		return newErrResponse(setupAction, response)
 	}
 
 	return nil
@@ -0,0 +1,183 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+const (
+	uploadBackupAction = "uploadBackup"
+
+	// exportBackupAction names the errors of ExportBackup, which assembles the backup from the client
+	// state instead of calling a server action.
+	exportBackupAction = "exportBackup"
+)
+
+// ImportHandle defines how existing monitors and notifications are handled when importing a backup.
+type ImportHandle string
+
+const (
+	// ImportHandleKeep keeps existing monitors and notifications and adds all entries of the backup.
+	ImportHandleKeep ImportHandle = "keep"
+
+	// ImportHandleOverwrite deletes all monitors, notifications and heartbeats before importing the
+	// backup.
+	ImportHandleOverwrite ImportHandle = "overwrite"
+
+	// ImportHandleSkip skips entries of the backup whose name already exists.
+	ImportHandleSkip ImportHandle = "skip"
+)
+
+// Backup is the JSON backup format of Uptime Kuma, as produced by the export in the settings of the
+// web interface. The proxy and tag lists are additions of this library, they are ignored by the
+// server on import.
+type Backup struct {
+	Version          string               `json:"version"`
+	NotificationList []state.Notification `json:"notificationList"`
+	MonitorList      []map[string]any     `json:"monitorList"`
+	ProxyList        []state.Proxy        `json:"proxyList,omitempty"`
+	TagList          []state.Tag          `json:"tagList,omitempty"`
+}
+
+type uploadBackupResponse struct {
+	Ok  bool    `mapstructure:"ok"`
+	Msg *string `mapstructure:"msg"`
+}
+
+// ExportBackup assembles a backup of the monitors, notifications, tags and proxies of the Uptime
+// Kuma instance from the client state. It waits for the monitor and notification lists to be
+// received, proxies are only included if the proxy list was received.
+func ExportBackup(ctx context.Context, c StatefulEmiter) (*Backup, error) {
+	// ensure monitor and notification lists are received
+	for _, event := range []string{handler.MonitorListEvent, handler.NotificationListEvent} {
+		if err := c.Await(ctx, event); err != nil {
+			return nil, wrapErrActionFailed(exportBackupAction, NewErrAwaitFailed(event, err))
+		}
+	}
+
+	// tags are not pushed by the server, fetch them
+	tags, err := GetTags(ctx, c)
+	if err != nil {
+		return nil, wrapErrActionFailed(exportBackupAction, err)
+	}
+
+	backup := &Backup{TagList: tags}
+
+	if info, err := c.State().Info(); err == nil && info.Version != nil {
+		backup.Version = *info.Version
+	}
+
+	notifications, err := c.State().Notifications()
+	if err != nil {
+		return nil, wrapErrActionFailed(exportBackupAction, err)
+	}
+
+	sort.Slice(notifications, func(i, j int) bool { return notifications[i].Id < notifications[j].Id })
+	backup.NotificationList = notifications
+
+	monitors, err := c.State().Monitors()
+	if err != nil {
+		return nil, wrapErrActionFailed(exportBackupAction, err)
+	}
+
+	backup.MonitorList = make([]map[string]any, 0, len(monitors))
+	for _, monitor := range monitors {
+		m, err := exportMonitor(monitor)
+		if err != nil {
+			return nil, wrapErrActionFailed(exportBackupAction, err)
+		}
+
+		backup.MonitorList = append(backup.MonitorList, m)
+	}
+
+	sort.Slice(backup.MonitorList, func(i, j int) bool {
+		return backup.MonitorList[i]["id"].(int) < backup.MonitorList[j]["id"].(int)
+	})
+
+	proxies, err := c.State().Proxies()
+	switch {
+	case err == nil:
+		sort.Slice(proxies, func(i, j int) bool { return proxies[i].Id < proxies[j].Id })
+		backup.ProxyList = proxies
+	case !errors.Is(err, state.ErrNotSetYet):
+		return nil, wrapErrActionFailed(exportBackupAction, err)
+	}
+
+	return backup, nil
+}
+
+// exportMonitor encodes the monitor as contained in the monitor list of a backup, including the
+// fields that are never sent with the add and edit actions.
+func exportMonitor(monitor *state.Monitor) (map[string]any, error) {
+	m, err := utils.EncodeMap(monitor)
+	if err != nil {
+		return nil, fmt.Errorf("encode monitor %d failed: %w", monitor.Id, err)
+	}
+
+	tags := monitor.Tags
+	if tags == nil {
+		tags = []state.MonitorTag{}
+	}
+
+	m["id"] = monitor.Id
+	m["active"] = monitor.Active
+	m["tags"] = tags
+	m["childrenIds"] = monitor.ChildrenIds
+	m["forceInactive"] = monitor.ForceInactive
+	m["includeSensitiveData"] = monitor.IncludeSensitiveData
+	m["maintenance"] = monitor.Maintenance
+	m["pathName"] = monitor.PathName
+
+	return m, nil
+}
+
+// UploadBackup imports the backup into the Uptime Kuma instance, handling existing monitors and
+// notifications as defined by handle. Only monitors and notifications are restored by the server.
+func UploadBackup(ctx context.Context, c StatefulEmiter, backup *Backup, handle ImportHandle) error {
+	if backup == nil {
+		return wrapErrActionFailed(uploadBackupAction, fmt.Errorf("%w: backup is nil", ErrValidation))
+	}
+
+	switch handle {
+	case ImportHandleKeep, ImportHandleOverwrite, ImportHandleSkip:
+	default:
+		return wrapErrActionFailed(uploadBackupAction, fmt.Errorf("%w: unknown import handle %q", ErrValidation, handle))
+	}
+
+	// the server expects the backup as JSON string
+	raw, err := json.Marshal(backup)
+	if err != nil {
+		return wrapErrActionFailed(uploadBackupAction, err)
+	}
+
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(uploadBackupAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, uploadBackupAction, string(raw), handle)
+	if err != nil {
+		return wrapErrActionFailed(uploadBackupAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &uploadBackupResponse{}
+	if err := decode(response, data); err != nil {
+		return wrapErrActionFailed(uploadBackupAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return newErrResponse(uploadBackupAction, response)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExportBackup(t *testing.T) {
+	s := state.NewState()
+	assert.NoError(t, s.SetInfo(&state.Info{Version: utils.NewString("1.23.11")}))
+	assert.NoError(t, s.SetNotifications([]state.Notification{
+		{Id: 2, Name: "Slack", Active: true, UserId: 1, Config: `{"type":"slack"}`},
+		{Id: 1, Name: "Webhook", Active: true, IsDefault: true, UserId: 1, Config: `{"type":"webhook"}`},
+	}))
+	assert.NoError(t, s.SetMonitors(map[int]*state.Monitor{
+		3: {Id: 3, Name: "API", Type: "http", Active: true, Tags: []state.MonitorTag{{Id: 1, MonitorId: 3, TagId: 4, Value: "prod", Name: "env", Color: "#000000"}}},
+		1: {Id: 1, Name: "Group", Type: "group", ChildrenIds: []int{3}, Unmapped: map[string]any{"kafkaProducerSsl": false}},
+	}))
+
+	c := mocks.NewStatefulEmiter(t)
+	c.EXPECT().State().Return(s)
+	c.EXPECT().Await(mock.Anything, mock.Anything).Return(nil)
+	c.EXPECT().Emit(mock.Anything, getTagsAction).Return([]any{[]byte(`{"ok":true,"tags":[{"id":4,"name":"env","color":"#000000"}]}`)}, nil)
+
+	backup, err := ExportBackup(context.Background(), c)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "1.23.11", backup.Version)
+	assert.Equal(t, []state.Tag{{Id: 4, Name: "env", Color: "#000000"}}, backup.TagList)
+	assert.Nil(t, backup.ProxyList)
+
+	if assert.Len(t, backup.NotificationList, 2) {
+		assert.Equal(t, 1, backup.NotificationList[0].Id)
+		assert.Equal(t, 2, backup.NotificationList[1].Id)
+	}
+
+	if assert.Len(t, backup.MonitorList, 2) {
+		assert.Equal(t, 1, backup.MonitorList[0]["id"])
+		assert.Equal(t, []int{3}, backup.MonitorList[0]["childrenIds"])
+		assert.Equal(t, false, backup.MonitorList[0]["kafkaProducerSsl"])
+
+		assert.Equal(t, 3, backup.MonitorList[1]["id"])
+		assert.Equal(t, true, backup.MonitorList[1]["active"])
+		assert.Equal(t, "http", backup.MonitorList[1]["type"])
+	}
+
+	// the encoded backup matches the format of the web interface
+	raw, err := json.Marshal(backup)
+	assert.NoError(t, err)
+
+	decoded := map[string]any{}
+	assert.NoError(t, json.Unmarshal(raw, &decoded))
+	assert.Equal(t, map[string]any{
+		"id":         1.0,
+		"name":       "Webhook",
+		"active":     true,
+		"is_default": true,
+		"user_id":    1.0,
+		"config":     `{"type":"webhook"}`,
+	}, decoded["notificationList"].([]any)[0])
+	assert.Equal(t, []any{map[string]any{
+		"id":         1.0,
+		"monitor_id": 3.0,
+		"tag_id":     4.0,
+		"value":      "prod",
+		"name":       "env",
+		"color":      "#000000",
+	}}, decoded["monitorList"].([]any)[1].(map[string]any)["tags"])
+}
+
+func TestExportBackup_Errors(t *testing.T) {
+	errAwait := errors.New("not connected")
+
+	tests := []struct {
+		name    string
+		setup   func(c *mocks.StatefulEmiter)
+		wantErr error
+	}{
+		{
+			name: "await failed",
+			setup: func(c *mocks.StatefulEmiter) {
+				c.EXPECT().Await(mock.Anything, handler.MonitorListEvent).Return(errAwait).Once()
+			},
+			wantErr: errAwait,
+		},
+		{
+			name: "get tags failed",
+			setup: func(c *mocks.StatefulEmiter) {
+				c.EXPECT().Await(mock.Anything, mock.Anything).Return(nil)
+				c.EXPECT().Emit(mock.Anything, getTagsAction).Return([]any{[]byte(`{"ok":false,"msg":"You are not logged in."}`)}, nil).Once()
+			},
+			wantErr: ErrNotLoggedIn,
+		},
+		{
+			name: "state not set",
+			setup: func(c *mocks.StatefulEmiter) {
+				c.EXPECT().Await(mock.Anything, mock.Anything).Return(nil)
+				c.EXPECT().Emit(mock.Anything, getTagsAction).Return([]any{[]byte(`{"ok":true,"tags":[]}`)}, nil).Once()
+				c.EXPECT().State().Return(state.NewState())
+			},
+			wantErr: state.ErrNotSetYet,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := mocks.NewStatefulEmiter(t)
+			tt.setup(c)
+
+			_, err := ExportBackup(context.Background(), c)
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			var actionErr ErrActionFailed
+			if assert.ErrorAs(t, err, &actionErr) {
+				assert.Equal(t, exportBackupAction, actionErr.Action)
+			}
+		})
+	}
+}
+
+func TestUploadBackup(t *testing.T) {
+	backup := &Backup{Version: "1.23.11", NotificationList: []state.Notification{}, MonitorList: []map[string]any{}}
+
+	c := mocks.NewStatefulEmiter(t)
+	c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(nil)
+	c.EXPECT().Emit(mock.Anything, uploadBackupAction, `{"version":"1.23.11","notificationList":[],"monitorList":[]}`, ImportHandleSkip).
+		Return([]any{[]byte(`{"ok":true,"msg":"Backup successfully restored."}`)}, nil)
+
+	assert.NoError(t, UploadBackup(context.Background(), c, backup, ImportHandleSkip))
+
+	// invalid import handles are rejected before calling the server
+	err := UploadBackup(context.Background(), c, backup, "replace")
+	assert.ErrorIs(t, err, ErrValidation)
+	assert.ErrorContains(t, err, `unknown import handle "replace"`)
+}
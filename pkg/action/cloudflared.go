@@ -0,0 +1,106 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+)
+
+const (
+	joinCloudflaredAction        = "cloudflared_join"
+	leaveCloudflaredAction       = "cloudflared_leave"
+	startCloudflaredAction       = "cloudflared_start"
+	stopCloudflaredAction        = "cloudflared_stop"
+	removeCloudflaredTokenAction = "cloudflared_removeToken"
+)
+
+// The cloudflared actions are not acknowledged by the server, except for a failed stop. Their
+// results are reported with the cloudflared events instead, which are handled by the Cloudflared
+// event handlers and stored in the client state.
+
+// stopCloudflaredTimeout is the time StopCloudflared waits for a failure to be reported.
+const stopCloudflaredTimeout = 5 * time.Second
+
+type stopCloudflaredResponse struct {
+	Ok  bool    `mapstructure:"ok"`
+	Msg *string `mapstructure:"msg"`
+}
+
+// JoinCloudflared subscribes the client to the status of the Cloudflare Tunnel. The server answers
+// with the installed, running and token events, followed by the message and error message events
+// whenever the tunnel status changes.
+func JoinCloudflared(ctx context.Context, c StatefulEmiter) error {
+	return sendCloudflaredAction(ctx, c, joinCloudflaredAction)
+}
+
+// LeaveCloudflared unsubscribes the client from the status of the Cloudflare Tunnel.
+func LeaveCloudflared(ctx context.Context, c StatefulEmiter) error {
+	return sendCloudflaredAction(ctx, c, leaveCloudflaredAction)
+}
+
+// StartCloudflared stores the tunnel token on the server and starts the Cloudflare Tunnel. If the
+// token is empty, the token already stored on the server is used.
+func StartCloudflared(ctx context.Context, c StatefulEmiter, token string) error {
+	return sendCloudflaredAction(ctx, c, startCloudflaredAction, token)
+}
+
+// StopCloudflared stops the Cloudflare Tunnel. The password of the current user is required unless
+// authentication is disabled. The server only acknowledges the action if stopping fails, e.g.
+// because of a wrong password, so the tunnel is considered stopped if no acknowledgement is
+// received within a few seconds. If the context is done before, its error is returned.
+func StopCloudflared(ctx context.Context, c StatefulEmiter, password string) error {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(stopCloudflaredAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action, waiting for a failure to be reported
+	emitCtx, cancel := context.WithTimeout(ctx, stopCloudflaredTimeout)
+	defer cancel()
+
+	response, err := c.Emit(emitCtx, stopCloudflaredAction, password)
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil:
+		// no failure has been reported in time
+		return nil
+	case err != nil:
+		return wrapErrActionFailed(stopCloudflaredAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &stopCloudflaredResponse{}
+	if err := decode(response, data); err != nil {
+		return wrapErrActionFailed(stopCloudflaredAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return newErrResponse(stopCloudflaredAction, response)
+	}
+
+	return nil
+}
+
+// RemoveCloudflaredToken removes the tunnel token stored on the server. A running tunnel is not
+// stopped.
+func RemoveCloudflaredToken(ctx context.Context, c StatefulEmiter) error {
+	return sendCloudflaredAction(ctx, c, removeCloudflaredTokenAction)
+}
+
+// sendCloudflaredAction sends a cloudflared action without waiting for an acknowledgement.
+func sendCloudflaredAction(ctx context.Context, c StatefulEmiter, action string, args ...any) error {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(action, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// send action
+	if err := c.Send(ctx, action, args...); err != nil {
+		return wrapErrActionFailed(action, err)
+	}
+
+	return nil
+}
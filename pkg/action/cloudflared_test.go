@@ -0,0 +1,101 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStartCloudflared(t *testing.T) {
+	for _, token := range []string{"token", ""} {
+		c := mocks.NewStatefulEmiter(t)
+		c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(nil).Once()
+		c.EXPECT().Send(mock.Anything, startCloudflaredAction, token).Return(nil).Once()
+
+		// an empty token starts the tunnel with the stored token
+		assert.NoError(t, StartCloudflared(context.Background(), c, token))
+	}
+}
+
+func TestStopCloudflared(t *testing.T) {
+	errTimeout := fmt.Errorf("timeout: %w", context.DeadlineExceeded)
+	errSend := errors.New("send failed")
+
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		response any
+		err      error
+		wantErr  error
+		wantMsg  string
+	}{
+		{
+			name: "no acknowledgement",
+			err:  errTimeout,
+		},
+		{
+			name:     "ok",
+			response: []any{[]byte(`{"ok":true}`)},
+		},
+		{
+			name:     "wrong password",
+			response: []any{[]byte(`{"ok":false,"msg":"Incorrect current password"}`)},
+			wantErr:  ErrInvalidCredentials,
+			wantMsg:  "Incorrect current password",
+		},
+		{
+			name:    "parent deadline exceeded",
+			ctx:     expired,
+			err:     errTimeout,
+			wantErr: context.DeadlineExceeded,
+			wantMsg: errTimeout.Error(),
+		},
+		{
+			name:    "emit failed",
+			err:     errSend,
+			wantErr: errSend,
+			wantMsg: "send failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := mocks.NewStatefulEmiter(t)
+			c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(nil).Once()
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			// the emit is bounded by the timeout of the action
+			c.EXPECT().Emit(mock.MatchedBy(func(ctx context.Context) bool {
+				deadline, ok := ctx.Deadline()
+				return ok && time.Until(deadline) <= stopCloudflaredTimeout
+			}), stopCloudflaredAction, "password").Return(tt.response, tt.err).Once()
+
+			err := StopCloudflared(ctx, c, "password")
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			var actionErr ErrActionFailed
+			if assert.ErrorAs(t, err, &actionErr) {
+				assert.Equal(t, stopCloudflaredAction, actionErr.Action)
+				assert.Equal(t, tt.wantMsg, actionErr.Msg)
+			}
+		})
+	}
+}
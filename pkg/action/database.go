@@ -0,0 +1,161 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+)
+
+const (
+	getDatabaseSizeAction = "getDatabaseSize"
+	shrinkDatabaseAction  = "shrinkDatabase"
+)
+
+type getDatabaseSizeResponse struct {
+	Ok   bool    `mapstructure:"ok"`
+	Msg  *string `mapstructure:"msg"`
+	Size *int64  `mapstructure:"size"`
+}
+
+type shrinkDatabaseResponse struct {
+	Ok  bool    `mapstructure:"ok"`
+	Msg *string `mapstructure:"msg"`
+}
+
+// DatabaseCleanup configures the steps of CleanupDatabase.
+type DatabaseCleanup struct {
+	// KeepDataPeriodDays is the new number of days heartbeats are kept, nil keeps the current
+	// setting. The server removes older heartbeats with its daily cleanup job, not right away.
+	KeepDataPeriodDays *int
+
+	// ClearStatistics clears the events and heartbeats of all monitors before shrinking.
+	ClearStatistics bool
+}
+
+// DatabaseCleanupResult is the result of CleanupDatabase, the sizes are in bytes.
+type DatabaseCleanupResult struct {
+	SizeBefore int64
+	SizeAfter  int64
+}
+
+// GetDatabaseSize returns the size of the database of the Uptime Kuma instance in bytes.
+func GetDatabaseSize(ctx context.Context, c StatefulEmiter) (int64, error) {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return 0, wrapErrActionFailed(getDatabaseSizeAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, getDatabaseSizeAction)
+	if err != nil {
+		return 0, wrapErrActionFailed(getDatabaseSizeAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &getDatabaseSizeResponse{}
+	if err := decode(response, data); err != nil {
+		return 0, wrapErrActionFailed(getDatabaseSizeAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return 0, newErrResponse(getDatabaseSizeAction, response)
+	}
+
+	// check if size is present
+	if data.Size == nil {
+		return 0, wrapErrActionFailed(getDatabaseSizeAction, ErrInvalidResponse)
+	}
+
+	return *data.Size, nil
+}
+
+// ShrinkDatabase shrinks the database of the Uptime Kuma instance, i.e. runs VACUUM on SQLite
+// databases to release the space of deleted data.
+func ShrinkDatabase(ctx context.Context, c StatefulEmiter) error {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(shrinkDatabaseAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, shrinkDatabaseAction)
+	if err != nil {
+		return wrapErrActionFailed(shrinkDatabaseAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &shrinkDatabaseResponse{}
+	if err := decode(response, data); err != nil {
+		return wrapErrActionFailed(shrinkDatabaseAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return newErrResponse(shrinkDatabaseAction, response)
+	}
+
+	return nil
+}
+
+// CleanupDatabase reports the size of the database, applies the retention of the cleanup, clears
+// the statistics if requested and shrinks the database. The password of the current user is
+// required to change the retention, see SetSettings. Returns the size before and after the cleanup.
+func CleanupDatabase(ctx context.Context, c StatefulEmiter, cleanup *DatabaseCleanup, password string) (*DatabaseCleanupResult, error) {
+	if cleanup == nil {
+		cleanup = &DatabaseCleanup{}
+	}
+
+	if cleanup.KeepDataPeriodDays != nil && *cleanup.KeepDataPeriodDays < 0 {
+		return nil, wrapErrActionFailed(setSettingsAction, fmt.Errorf("%w: keep data period days must not be negative", ErrValidation))
+	}
+
+	result := &DatabaseCleanupResult{}
+
+	sizeBefore, err := GetDatabaseSize(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	result.SizeBefore = sizeBefore
+
+	// the server stores all settings sent, so update the current settings instead of sending only
+	// the retention
+	if cleanup.KeepDataPeriodDays != nil {
+		settings, err := GetSettings(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		// sending empty settings would reset all other settings
+		if settings == nil {
+			return nil, wrapErrActionFailed(getSettingsAction, ErrInvalidResponse)
+		}
+
+		settings.KeepDataPeriodDays = cleanup.KeepDataPeriodDays
+
+		if err := SetSettings(ctx, c, settings, password); err != nil {
+			return nil, err
+		}
+	}
+
+	if cleanup.ClearStatistics {
+		if err := ClearStatistics(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ShrinkDatabase(ctx, c); err != nil {
+		return nil, err
+	}
+
+	sizeAfter, err := GetDatabaseSize(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	result.SizeAfter = sizeAfter
+
+	return result, nil
+}
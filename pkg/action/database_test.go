@@ -0,0 +1,80 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCleanupDatabase(t *testing.T) {
+	c := mocks.NewStatefulEmiter(t)
+	c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(nil)
+
+	c.EXPECT().Emit(mock.Anything, getDatabaseSizeAction).Return([]any{[]byte(`{"ok":true,"size":4096000}`)}, nil).Once()
+	c.EXPECT().Emit(mock.Anything, getSettingsAction).
+		Return([]any{[]byte(`{"ok":true,"data":{"keepDataPeriodDays":180,"entryPage":"dashboard","chromeExecutable":"#"}}`)}, nil).Once()
+	c.EXPECT().Emit(mock.Anything, setSettingsAction, &Settings{
+		KeepDataPeriodDays: utils.NewInt(30),
+		EntryPage:          utils.NewString("dashboard"),
+		Unmapped:           map[string]any{"chromeExecutable": "#"},
+	}, "secret").Return([]any{[]byte(`{"ok":true}`)}, nil).Once()
+	c.EXPECT().Emit(mock.Anything, clearStatisticsAction).Return([]any{[]byte(`{"ok":true}`)}, nil).Once()
+	c.EXPECT().Emit(mock.Anything, shrinkDatabaseAction).Return([]any{[]byte(`{"ok":true}`)}, nil).Once()
+	c.EXPECT().Emit(mock.Anything, getDatabaseSizeAction).Return([]any{[]byte(`{"ok":true,"size":1024000}`)}, nil).Once()
+
+	got, err := CleanupDatabase(context.Background(), c, &DatabaseCleanup{
+		KeepDataPeriodDays: utils.NewInt(30),
+		ClearStatistics:    true,
+	}, "secret")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &DatabaseCleanupResult{SizeBefore: 4096000, SizeAfter: 1024000}, got)
+}
+
+func TestCleanupDatabase_ShrinkOnly(t *testing.T) {
+	c := mocks.NewStatefulEmiter(t)
+	c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(nil)
+
+	c.EXPECT().Emit(mock.Anything, getDatabaseSizeAction).Return([]any{[]byte(`{"ok":true,"size":2048}`)}, nil).Once()
+	c.EXPECT().Emit(mock.Anything, shrinkDatabaseAction).Return([]any{[]byte(`{"ok":false,"msg":"database is locked"}`)}, nil).Once()
+
+	_, err := CleanupDatabase(context.Background(), c, nil, "")
+	assert.ErrorContains(t, err, "database is locked")
+
+	// negative retention is rejected before calling the server
+	_, err = CleanupDatabase(context.Background(), c, &DatabaseCleanup{KeepDataPeriodDays: utils.NewInt(-1)}, "")
+	assert.ErrorIs(t, err, ErrValidation)
+}
+
+func TestCleanupDatabase_NoSettings(t *testing.T) {
+	c := mocks.NewStatefulEmiter(t)
+	c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(nil)
+
+	// the settings are not set if the current ones are missing
+	c.EXPECT().Emit(mock.Anything, getDatabaseSizeAction).Return([]any{[]byte(`{"ok":true,"size":2048}`)}, nil).Once()
+	c.EXPECT().Emit(mock.Anything, getSettingsAction).Return([]any{[]byte(`{"ok":true}`)}, nil).Once()
+
+	_, err := CleanupDatabase(context.Background(), c, &DatabaseCleanup{KeepDataPeriodDays: utils.NewInt(30)}, "secret")
+	assert.ErrorIs(t, err, ErrInvalidResponse)
+
+	var actionErr ErrActionFailed
+	if assert.ErrorAs(t, err, &actionErr) {
+		assert.Equal(t, getSettingsAction, actionErr.Action)
+	}
+}
+
+func TestSettings_MarshalJSON(t *testing.T) {
+	got, err := json.Marshal(&Settings{
+		KeepDataPeriodDays: utils.NewInt(30),
+		Unmapped:           map[string]any{"chromeExecutable": "#", "keepDataPeriodDays": 1},
+	})
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"keepDataPeriodDays":30,"chromeExecutable":"#"}`, string(got))
+}
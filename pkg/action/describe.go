@@ -0,0 +1,66 @@
+package action
+
+import "github.com/nobbs/uptime-kuma-api/pkg/state"
+
+// monitorIdArgs maps actions to the index of their monitor id argument.
+var monitorIdArgs = map[string]int{
+	getMonitorAction:       0,
+	deleteMonitorAction:    0,
+	pauseMonitorAction:     0,
+	resumeMonitorAction:    0,
+	getMonitorBeatsAction:  0,
+	clearEventsAction:      0,
+	clearHeartbeatsAction:  0,
+	addMonitorTagAction:    1,
+	editMonitorTagAction:   1,
+	deleteMonitorTagAction: 1,
+}
+
+// MonitorId returns the id of the monitor the given action has been called for, based on its
+// arguments. Returns false if the action does not refer to an existing monitor.
+func MonitorId(action string, args []any) (int, bool) {
+	if action == editMonitorAction && len(args) > 0 {
+		if monitor, ok := args[0].(*state.Monitor); ok && monitor != nil {
+			return monitor.Id, true
+		}
+
+		return 0, false
+	}
+
+	i, ok := monitorIdArgs[action]
+	if !ok || i >= len(args) {
+		return 0, false
+	}
+
+	monitorId, ok := args[i].(int)
+
+	return monitorId, ok
+}
+
+// idempotentActions lists the actions that only read data from the server. Calling them more than
+// once has no side effects, so they are safe to retry. All other actions change data on the server
+// and a failed call may still have been applied, e.g. if only the acknowledgement timed out.
+var idempotentActions = map[string]struct{}{
+	getMonitorAction:               {},
+	getMonitorListAction:           {},
+	getMonitorBeatsAction:          {},
+	getTagsAction:                  {},
+	getSettingsAction:              {},
+	getMaintenanceAction:           {},
+	getMaintenanceListAction:       {},
+	getMonitorMaintenanceAction:    {},
+	getMaintenanceStatusPageAction: {},
+	getStatusPageAction:            {},
+	getAPIKeyListAction:            {},
+	getDatabaseSizeAction:          {},
+	getGameListAction:              {},
+	checkAppriseAction:             {},
+	needSetupAction:                {},
+	twoFAStatusAction:              {},
+}
+
+// IsIdempotent returns true if the given action only reads data and is therefore safe to retry.
+func IsIdempotent(action string) bool {
+	_, ok := idempotentActions[action]
+	return ok
+}
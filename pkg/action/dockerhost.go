@@ -0,0 +1,180 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+)
+
+const (
+	addDockerHostAction    = "addDockerHost"
+	deleteDockerHostAction = "deleteDockerHost"
+	testDockerHostAction   = "testDockerHost"
+)
+
+type addDockerHostResponse struct {
+	Ok           bool    `mapstructure:"ok"`
+	Msg          *string `mapstructure:"msg"`
+	DockerHostId *int    `mapstructure:"id"`
+}
+
+type deleteDockerHostResponse struct {
+	Ok  bool    `mapstructure:"ok"`
+	Msg *string `mapstructure:"msg"`
+}
+
+type testDockerHostResponse struct {
+	Ok  bool    `mapstructure:"ok"`
+	Msg *string `mapstructure:"msg"`
+}
+
+// ValidateDockerHost returns an error wrapping ErrValidation if the name or daemon of the docker
+// host is missing, or if the daemon does not match the type: an absolute socket path for the socket
+// type, a tcp, http or https URL for the tcp type.
+func ValidateDockerHost(dockerHost *state.DockerHost) error {
+	if dockerHost == nil {
+		return fmt.Errorf("%w: docker host is nil", ErrValidation)
+	}
+
+	if dockerHost.Name == "" {
+		return fmt.Errorf("%w: docker host name is required", ErrValidation)
+	}
+
+	if dockerHost.DockerDaemon == "" {
+		return fmt.Errorf("%w: docker daemon is required", ErrValidation)
+	}
+
+	switch dockerHost.DockerType {
+	case state.DockerHostTypeSocket:
+		if !strings.HasPrefix(dockerHost.DockerDaemon, "/") {
+			return fmt.Errorf("%w: docker daemon %q is not an absolute socket path", ErrValidation, dockerHost.DockerDaemon)
+		}
+	case state.DockerHostTypeTCP:
+		u, err := url.Parse(dockerHost.DockerDaemon)
+		if err != nil || u.Host == "" || (u.Scheme != "tcp" && u.Scheme != "http" && u.Scheme != "https") {
+			return fmt.Errorf("%w: docker daemon %q is not a tcp, http or https url", ErrValidation, dockerHost.DockerDaemon)
+		}
+	default:
+		return fmt.Errorf("%w: unknown docker type %q", ErrValidation, dockerHost.DockerType)
+	}
+
+	return nil
+}
+
+// AddDockerHost adds a new docker host to the Uptime Kuma instance and returns its id.
+func AddDockerHost(ctx context.Context, c StatefulEmiter, dockerHost *state.DockerHost) (int, error) {
+	return saveDockerHost(ctx, c, nil, dockerHost)
+}
+
+// EditDockerHost replaces the configuration of the docker host with the given id.
+func EditDockerHost(ctx context.Context, c StatefulEmiter, dockerHostId int, dockerHost *state.DockerHost) error {
+	_, err := saveDockerHost(ctx, c, &dockerHostId, dockerHost)
+	return err
+}
+
+// saveDockerHost creates a new docker host if dockerHostId is nil, otherwise it edits the existing
+// docker host.
+func saveDockerHost(ctx context.Context, c StatefulEmiter, dockerHostId *int, dockerHost *state.DockerHost) (int, error) {
+	// validate docker host before sending it
+	if err := ValidateDockerHost(dockerHost); err != nil {
+		return 0, wrapErrActionFailed(addDockerHostAction, err)
+	}
+
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return 0, wrapErrActionFailed(addDockerHostAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action, a nil id creates a new docker host
+	response, err := c.Emit(ctx, addDockerHostAction, dockerHost, dockerHostId)
+	if err != nil {
+		return 0, wrapErrActionFailed(addDockerHostAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &addDockerHostResponse{}
+	if err := decode(response, data); err != nil {
+		return 0, wrapErrActionFailed(addDockerHostAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return 0, newErrResponse(addDockerHostAction, response)
+	}
+
+	// check if docker host id is present
+	if data.DockerHostId == nil {
+		return 0, wrapErrActionFailed(addDockerHostAction, ErrInvalidResponse)
+	}
+
+	return *data.DockerHostId, nil
+}
+
+// DeleteDockerHost deletes the docker host with the given id. Monitors using the docker host are
+// updated to not use it anymore.
+func DeleteDockerHost(ctx context.Context, c StatefulEmiter, dockerHostId int) error {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(deleteDockerHostAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, deleteDockerHostAction, dockerHostId)
+	if err != nil {
+		return wrapErrActionFailed(deleteDockerHostAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &deleteDockerHostResponse{}
+	if err := decode(response, data); err != nil {
+		return wrapErrActionFailed(deleteDockerHostAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return newErrResponse(deleteDockerHostAction, response)
+	}
+
+	return nil
+}
+
+// TestDockerHost tests the connection to the given docker host, which does not need to be saved.
+// Returns the message of the server, which includes the number of containers.
+func TestDockerHost(ctx context.Context, c StatefulEmiter, dockerHost *state.DockerHost) (string, error) {
+	// validate docker host before sending it
+	if err := ValidateDockerHost(dockerHost); err != nil {
+		return "", wrapErrActionFailed(testDockerHostAction, err)
+	}
+
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return "", wrapErrActionFailed(testDockerHostAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, testDockerHostAction, dockerHost)
+	if err != nil {
+		return "", wrapErrActionFailed(testDockerHostAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &testDockerHostResponse{}
+	if err := decode(response, data); err != nil {
+		return "", wrapErrActionFailed(testDockerHostAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return "", newErrResponse(testDockerHostAction, response)
+	}
+
+	if data.Msg == nil {
+		return "", nil
+	}
+
+	return *data.Msg, nil
+}
@@ -0,0 +1,64 @@
+package action
+
+import (
+	"testing"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDockerHost(t *testing.T) {
+	tests := []struct {
+		name       string
+		dockerHost *state.DockerHost
+		want       *string
+	}{
+		{
+			name:       "socket",
+			dockerHost: &state.DockerHost{Name: "local", DockerDaemon: "/var/run/docker.sock", DockerType: state.DockerHostTypeSocket},
+		},
+		{
+			name:       "tcp",
+			dockerHost: &state.DockerHost{Name: "remote", DockerDaemon: "tcp://docker.example.com:2375", DockerType: state.DockerHostTypeTCP},
+		},
+		{
+			name:       "https",
+			dockerHost: &state.DockerHost{Name: "remote", DockerDaemon: "https://docker.example.com:2376", DockerType: state.DockerHostTypeTCP},
+		},
+		{
+			name:       "missing name",
+			dockerHost: &state.DockerHost{DockerDaemon: "/var/run/docker.sock", DockerType: state.DockerHostTypeSocket},
+			want:       utils.NewString("docker host name is required"),
+		},
+		{
+			name:       "relative socket",
+			dockerHost: &state.DockerHost{Name: "local", DockerDaemon: "docker.sock", DockerType: state.DockerHostTypeSocket},
+			want:       utils.NewString(`docker daemon "docker.sock" is not an absolute socket path`),
+		},
+		{
+			name:       "socket path as tcp",
+			dockerHost: &state.DockerHost{Name: "remote", DockerDaemon: "/var/run/docker.sock", DockerType: state.DockerHostTypeTCP},
+			want:       utils.NewString("is not a tcp, http or https url"),
+		},
+		{
+			name:       "unknown type",
+			dockerHost: &state.DockerHost{Name: "remote", DockerDaemon: "ssh://docker", DockerType: "ssh"},
+			want:       utils.NewString(`unknown docker type "ssh"`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDockerHost(tt.dockerHost)
+
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, ErrValidation)
+			assert.ErrorContains(t, err, *tt.want)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package action
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // ErrInvalidResponse is returned when the response from the server is invalid.
@@ -11,36 +12,148 @@ var ErrInvalidResponse = errors.New("invalid response")
 // Err2faTokenRequired is returned when a 2fa token is required to login.
 var Err2faTokenRequired = errors.New("2fa token required")
 
+// ErrNotLoggedIn is returned when an action requires the client to be logged in.
+var ErrNotLoggedIn = errors.New("not logged in")
+
+// ErrInvalidCredentials is returned when the username, password or token is incorrect.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// ErrRateLimited is returned when the server rejects a login because of too many attempts.
+var ErrRateLimited = errors.New("rate limited")
+
+// ErrPermissionDenied is returned when the user is not allowed to access a resource, e.g. a monitor
+// owned by another user.
+var ErrPermissionDenied = errors.New("permission denied")
+
+// ErrNotFound is returned when the resource an action refers to does not exist.
+var ErrNotFound = errors.New("not found")
+
+// ErrValidation is returned when the server rejects the data sent with an action as invalid.
+var ErrValidation = errors.New("validation failed")
+
+// msgKinds maps fragments of server messages, both plain and i18n keys, to the sentinel errors.
+// The first matching fragment wins, so more specific fragments come first.
+var msgKinds = []struct {
+	fragment string
+	err      error
+}{
+	{"not logged in", ErrNotLoggedIn},
+	{"too frequently", ErrRateLimited},
+	{"ratelimit", ErrRateLimited},
+	{"rate limit", ErrRateLimited},
+	{"incorrect username or password", ErrInvalidCredentials},
+	{"authincorrectcreds", ErrInvalidCredentials},
+	{"authinvalidtoken", ErrInvalidCredentials},
+	{"invalid token", ErrInvalidCredentials},
+	{"incorrect current password", ErrInvalidCredentials},
+	{"do not own", ErrPermissionDenied},
+	{"permission", ErrPermissionDenied},
+	{"not found", ErrNotFound},
+	{"notfound", ErrNotFound},
+	{"cannot read properties of null", ErrNotFound},
+	{"invalid url", ErrValidation},
+	{"invalid cron", ErrValidation},
+	{"invalid date", ErrValidation},
+	{"invalid json", ErrValidation},
+	{"invalid input", ErrValidation},
+	{"cannot be", ErrValidation},
+	{"must be", ErrValidation},
+	{"is required", ErrValidation},
+	{"are required", ErrValidation},
+	{"too weak", ErrValidation},
+	{"too long", ErrValidation},
+	{"too short", ErrValidation},
+}
+
+// classify returns the sentinel error matching the server message, nil if there is none.
+func classify(msg string) error {
+	msg = strings.ToLower(msg)
+
+	for _, kind := range msgKinds {
+		if strings.Contains(msg, kind.fragment) {
+			return kind.err
+		}
+	}
+
+	return nil
+}
+
+// ErrAwaitFailed is returned when awaiting an event fails, e.g. because the client didn't connect in
+// time. Actions return it wrapped in an ErrActionFailed, so the failed action can be identified.
 type ErrAwaitFailed struct {
 	Event string
 	err   error
 }
 
+// ErrActionFailed is returned when an action fails, either because it couldn't be sent or decoded,
+// or because the server reported a failure. It wraps the cause, so errors.Is can be used with the
+// sentinel errors of this package and of the client, e.g. ErrNotFound.
 type ErrActionFailed struct {
 	Action string
 	Msg    string
+
+	// MsgI18n is true if Msg is a translation key of the Uptime Kuma frontend.
+	MsgI18n bool
+
+	err error
 }
 
+// ErrLoginFailed is returned when a login fails.
+//
+// Deprecated: failed logins are reported as ErrActionFailed, wrapping ErrInvalidCredentials or
+// ErrRateLimited where applicable.
 type ErrLoginFailed struct {
 	Msg string
+	err error
 }
 
 func NewErrActionFailed(action, msg string) ErrActionFailed {
-	return ErrActionFailed{Action: action, Msg: msg}
+	return ErrActionFailed{Action: action, Msg: msg, err: classify(msg)}
+}
+
+// wrapErrActionFailed returns an ErrActionFailed wrapping the given error.
+func wrapErrActionFailed(action string, err error) ErrActionFailed {
+	return ErrActionFailed{Action: action, Msg: err.Error(), err: err}
+}
+
+// newErrResponse returns an ErrActionFailed for a response reporting a failure. A response without
+// a message is reported as ErrInvalidResponse.
+func newErrResponse(action string, response any) ErrActionFailed {
+	status, err := DecodeStatus(response)
+	if err != nil {
+		return wrapErrActionFailed(action, err)
+	}
+
+	if status.Msg == nil || *status.Msg == "" {
+		return wrapErrActionFailed(action, ErrInvalidResponse)
+	}
+
+	e := NewErrActionFailed(action, *status.Msg)
+	e.MsgI18n = status.MsgI18n
+
+	return e
 }
 
 func (e ErrActionFailed) Error() string {
-	return fmt.Sprintf("action failed: %s", e.Msg)
+	return fmt.Sprintf("action %s failed: %s", e.Action, e.Msg)
+}
+
+func (e ErrActionFailed) Unwrap() error {
+	return e.err
 }
 
 func NewErrLoginFailed(msg string) ErrLoginFailed {
-	return ErrLoginFailed{Msg: msg}
+	return ErrLoginFailed{Msg: msg, err: classify(msg)}
 }
 
 func (e ErrLoginFailed) Error() string {
 	return fmt.Sprintf("auth failed: %s", e.Msg)
 }
 
+func (e ErrLoginFailed) Unwrap() error {
+	return e.err
+}
+
 func NewErrAwaitFailed(event string, err error) ErrAwaitFailed {
 	return ErrAwaitFailed{Event: event, err: err}
 }
@@ -48,3 +161,7 @@ func NewErrAwaitFailed(event string, err error) ErrAwaitFailed {
 func (e ErrAwaitFailed) Error() string {
 	return fmt.Sprintf("await failed for event %s: %s", e.Event, e.err)
 }
+
+func (e ErrAwaitFailed) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,122 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewErrActionFailed(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		want error
+	}{
+		{name: "not logged in", msg: "You are not logged in.", want: ErrNotLoggedIn},
+		{name: "rate limited", msg: "Too frequently, try again later.", want: ErrRateLimited},
+		{name: "invalid credentials", msg: "Incorrect username or password.", want: ErrInvalidCredentials},
+		{name: "invalid credentials i18n", msg: "authIncorrectCreds", want: ErrInvalidCredentials},
+		{name: "invalid token", msg: "Invalid Token", want: ErrInvalidCredentials},
+		{name: "permission denied", msg: "You do not own this monitor.", want: ErrPermissionDenied},
+		{name: "not found", msg: "Tag not found", want: ErrNotFound},
+		{name: "not found null bean", msg: "Cannot read properties of null (reading 'toJSON')", want: ErrNotFound},
+		{name: "incorrect current password", msg: "Incorrect current password", want: ErrInvalidCredentials},
+		{name: "validation", msg: "Interval cannot be more than 24 hours", want: ErrValidation},
+		{name: "validation invalid", msg: "Invalid URL", want: ErrValidation},
+		{name: "validation required", msg: "Name is required", want: ErrValidation},
+		{name: "2fa token required", msg: "2FA token required", want: nil},
+		{name: "invalid token lowercase", msg: "invalid token", want: ErrInvalidCredentials},
+		{name: "unknown", msg: "something went wrong", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewErrActionFailed("getMonitor", tt.msg)
+
+			assert.Equal(t, "getMonitor", got.Action)
+			assert.Equal(t, tt.msg, got.Msg)
+			assert.Equal(t, tt.want, errors.Unwrap(got))
+		})
+	}
+}
+
+func TestNewErrResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		response any
+		want     error
+		msg      *string
+		msgI18n  bool
+	}{
+		{
+			name:     "message",
+			response: []any{[]byte(`{"ok":false,"msg":"You are not logged in."}`)},
+			want:     ErrNotLoggedIn,
+			msg:      utils.NewString("You are not logged in."),
+		},
+		{
+			name:     "i18n message",
+			response: []any{[]byte(`{"ok":false,"msg":"authIncorrectCreds","msgi18n":true}`)},
+			want:     ErrInvalidCredentials,
+			msg:      utils.NewString("authIncorrectCreds"),
+			msgI18n:  true,
+		},
+		{
+			name:     "no message",
+			response: []any{[]byte(`{"ok":false}`)},
+			want:     ErrInvalidResponse,
+		},
+		{
+			name:     "invalid response",
+			response: "invalid",
+			want:     ErrInvalidResponse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newErrResponse("login", tt.response)
+
+			var actionErr ErrActionFailed
+			if assert.ErrorAs(t, got, &actionErr) {
+				assert.Equal(t, "login", actionErr.Action)
+				assert.Equal(t, tt.msgI18n, actionErr.MsgI18n)
+			}
+
+			if tt.msg != nil {
+				assert.Equal(t, *tt.msg, got.Msg)
+			}
+
+			assert.ErrorIs(t, got, tt.want)
+		})
+	}
+}
+
+func TestAwaitFailed(t *testing.T) {
+	errTimeout := errors.New("timeout")
+
+	c := mocks.NewStatefulEmiter(t)
+	c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(errTimeout).Once()
+
+	err := GetMonitorList(context.Background(), c)
+
+	// the failed await can be traced to the action
+	var actionErr ErrActionFailed
+	if assert.ErrorAs(t, err, &actionErr) {
+		assert.Equal(t, getMonitorListAction, actionErr.Action)
+	}
+
+	var awaitErr ErrAwaitFailed
+	if assert.ErrorAs(t, err, &awaitErr) {
+		assert.Equal(t, handler.ConnectEvent, awaitErr.Event)
+	}
+
+	assert.ErrorIs(t, err, errTimeout)
+	assert.EqualError(t, err, "action getMonitorList failed: await failed for event connection: timeout")
+}
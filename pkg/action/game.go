@@ -0,0 +1,89 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+)
+
+const (
+	getGameListAction = "getGameList"
+)
+
+// gamedigMonitorType is the type of monitors querying game servers.
+const gamedigMonitorType = "gamedig"
+
+type getGameListResponse struct {
+	Ok       bool         `mapstructure:"ok"`
+	Msg      *string      `mapstructure:"msg"`
+	GameList []state.Game `mapstructure:"gameList"`
+}
+
+// GetGameList returns the games supported by gamedig monitors. The games are also stored in the
+// client state.
+func GetGameList(ctx context.Context, c StatefulEmiter) ([]state.Game, error) {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return nil, wrapErrActionFailed(getGameListAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, getGameListAction)
+	if err != nil {
+		return nil, wrapErrActionFailed(getGameListAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &getGameListResponse{}
+	if err := decode(response, data); err != nil {
+		return nil, wrapErrActionFailed(getGameListAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return nil, newErrResponse(getGameListAction, response)
+	}
+
+	// check if game list is present
+	if data.GameList == nil {
+		return nil, wrapErrActionFailed(getGameListAction, ErrInvalidResponse)
+	}
+
+	// set games in state
+	if err := c.State().SetGames(data.GameList); err != nil {
+		return nil, wrapErrActionFailed(getGameListAction, err)
+	}
+
+	return data.GameList, nil
+}
+
+// validateMonitorGame returns an error wrapping ErrValidation if the monitor is a gamedig monitor
+// and its game is missing or unknown. The game list is fetched if it is not in the state yet.
+func validateMonitorGame(ctx context.Context, c StatefulEmiter, monitor *state.Monitor) error {
+	if monitor == nil || monitor.Type != gamedigMonitorType {
+		return nil
+	}
+
+	if monitor.Game == nil || *monitor.Game == "" {
+		return fmt.Errorf("%w: game is required for gamedig monitors", ErrValidation)
+	}
+
+	_, err := c.State().Game(*monitor.Game)
+	if errors.Is(err, state.ErrNotSetYet) {
+		if _, err := GetGameList(ctx, c); err != nil {
+			return err
+		}
+
+		_, err = c.State().Game(*monitor.Game)
+	}
+
+	var notFound *state.ErrNotFound
+	if errors.As(err, &notFound) {
+		return fmt.Errorf("%w: unknown game %q", ErrValidation, *monitor.Game)
+	}
+
+	return err
+}
@@ -0,0 +1,95 @@
+package action
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const gameListResponse = `{
+	"ok": true,
+	"gameList": [
+		{"keys": ["minecraft", "minecraftping"], "pretty": "Minecraft (2009)", "options": {"port": 25565, "protocol": "minecraft"}, "extra": {}},
+		{"keys": ["7d2d"], "pretty": "7 Days to Die (2013)", "options": {"port": 26900, "protocol": "valve", "port_query_offset": 1}}
+	]
+}`
+
+func TestGetGameList(t *testing.T) {
+	s := state.NewState()
+
+	c := mocks.NewStatefulEmiter(t)
+	c.EXPECT().State().Return(s)
+	c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(nil)
+	c.EXPECT().Emit(mock.Anything, getGameListAction).Return([]any{[]byte(gameListResponse)}, nil).Once()
+
+	got, err := GetGameList(context.Background(), c)
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+
+	game, err := s.Game("minecraftping")
+	assert.NoError(t, err)
+	assert.Equal(t, "Minecraft (2009)", game.Pretty)
+	assert.Equal(t, 25565, game.Options.Port)
+
+	game, err = s.Game("7d2d")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"port_query_offset": 1.0}, game.Options.Unmapped)
+
+	_, err = s.Game("tetris")
+	assert.ErrorContains(t, err, `game with name "tetris" not found`)
+}
+
+func TestAddMonitor_Game(t *testing.T) {
+	tests := []struct {
+		name    string
+		monitor *state.Monitor
+		want    *string
+	}{
+		{
+			name:    "known game",
+			monitor: &state.Monitor{Type: "gamedig", Game: utils.NewString("minecraft")},
+		},
+		{
+			name:    "unknown game",
+			monitor: &state.Monitor{Type: "gamedig", Game: utils.NewString("tetris")},
+			want:    utils.NewString(`unknown game "tetris"`),
+		},
+		{
+			name:    "missing game",
+			monitor: &state.Monitor{Type: "gamedig"},
+			want:    utils.NewString("game is required for gamedig monitors"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := state.NewState()
+
+			c := mocks.NewStatefulEmiter(t)
+			c.EXPECT().State().Return(s).Maybe()
+			c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(nil)
+			c.EXPECT().Emit(mock.Anything, getGameListAction).Return([]any{[]byte(gameListResponse)}, nil).Maybe()
+
+			if tt.want == nil {
+				c.EXPECT().Emit(mock.Anything, addMonitorAction, tt.monitor).Return([]any{[]byte(`{"ok":true,"monitorID":3}`)}, nil).Once()
+			}
+
+			got, err := AddMonitor(context.Background(), c, tt.monitor)
+
+			if tt.want == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, 3, got)
+				return
+			}
+
+			assert.ErrorIs(t, err, ErrValidation)
+			assert.ErrorContains(t, err, *tt.want)
+		})
+	}
+}
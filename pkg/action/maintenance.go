@@ -0,0 +1,375 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+)
+
+const (
+	getMaintenanceAction     = "getMaintenance"
+	getMaintenanceListAction = "getMaintenanceList"
+
+	addMaintenanceAction    = "addMaintenance"
+	editMaintenanceAction   = "editMaintenance"
+	deleteMaintenanceAction = "deleteMaintenance"
+	pauseMaintenanceAction  = "pauseMaintenance"
+	resumeMaintenanceAction = "resumeMaintenance"
+
+	addMonitorMaintenanceAction    = "addMonitorMaintenance"
+	getMonitorMaintenanceAction    = "getMonitorMaintenance"
+	addMaintenanceStatusPageAction = "addMaintenanceStatusPage"
+	getMaintenanceStatusPageAction = "getMaintenanceStatusPage"
+)
+
+type getMaintenanceListResponse struct {
+	Ok  bool    `mapstructure:"ok"`
+	Msg *string `mapstructure:"msg"`
+}
+
+type getMaintenanceResponse struct {
+	Ok          bool               `mapstructure:"ok"`
+	Msg         *string            `mapstructure:"msg"`
+	Maintenance *state.Maintenance `mapstructure:"maintenance"`
+}
+
+type saveMaintenanceResponse struct {
+	Ok            bool    `mapstructure:"ok"`
+	Msg           *string `mapstructure:"msg"`
+	MaintenanceId *int    `mapstructure:"maintenanceID"`
+}
+
+type maintenanceStatusResponse struct {
+	Ok  bool    `mapstructure:"ok"`
+	Msg *string `mapstructure:"msg"`
+}
+
+type getMonitorMaintenanceResponse struct {
+	Ok       bool    `mapstructure:"ok"`
+	Msg      *string `mapstructure:"msg"`
+	Monitors []struct {
+		Id int `mapstructure:"id"`
+	} `mapstructure:"monitors"`
+}
+
+type getMaintenanceStatusPageResponse struct {
+	Ok          bool    `mapstructure:"ok"`
+	Msg         *string `mapstructure:"msg"`
+	StatusPages []struct {
+		Id int `mapstructure:"id"`
+	} `mapstructure:"statusPages"`
+}
+
+// idList is the list of objects with an id the server expects when assigning monitors or status
+// pages to a maintenance.
+type idList []struct {
+	Id int `json:"id"`
+}
+
+// newIdList converts the given ids into an idList.
+func newIdList(ids []int) idList {
+	list := make(idList, len(ids))
+	for i, id := range ids {
+		list[i].Id = id
+	}
+
+	return list
+}
+
+// ValidateMaintenance returns an error wrapping ErrValidation if a field required by the strategy of
+// the maintenance is missing or invalid.
+func ValidateMaintenance(maintenance *state.Maintenance) error {
+	if maintenance == nil {
+		return fmt.Errorf("%w: maintenance is nil", ErrValidation)
+	}
+
+	if maintenance.Title == "" {
+		return fmt.Errorf("%w: maintenance title is required", ErrValidation)
+	}
+
+	switch maintenance.Strategy {
+	case state.MaintenanceStrategyManual:
+		return nil
+	case state.MaintenanceStrategySingle:
+		if len(maintenance.DateRange) != 2 || maintenance.DateRange[0] == "" || maintenance.DateRange[1] == "" {
+			return fmt.Errorf("%w: single maintenance requires a start and end date", ErrValidation)
+		}
+
+		return nil
+	case state.MaintenanceStrategyRecurringInterval:
+		if maintenance.IntervalDay < 1 || maintenance.IntervalDay > 3650 {
+			return fmt.Errorf("%w: intervalDay of maintenance must be between 1 and 3650", ErrValidation)
+		}
+	case state.MaintenanceStrategyRecurringWeekday:
+		if len(maintenance.Weekdays) == 0 {
+			return fmt.Errorf("%w: recurring-weekday maintenance requires weekdays", ErrValidation)
+		}
+	case state.MaintenanceStrategyRecurringDayOfMonth:
+		if len(maintenance.DaysOfMonth) == 0 {
+			return fmt.Errorf("%w: recurring-day-of-month maintenance requires days of month", ErrValidation)
+		}
+	case state.MaintenanceStrategyCron:
+		if maintenance.Cron == "" {
+			return fmt.Errorf("%w: cron maintenance requires a cron expression", ErrValidation)
+		}
+
+		if maintenance.DurationMinutes < 1 {
+			return fmt.Errorf("%w: cron maintenance requires a duration", ErrValidation)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown maintenance strategy %q", ErrValidation, maintenance.Strategy)
+	}
+
+	// the recurring strategies are active within the time range
+	if len(maintenance.TimeRange) != 2 {
+		return fmt.Errorf("%w: %s maintenance requires a start and end time", ErrValidation, maintenance.Strategy)
+	}
+
+	return nil
+}
+
+// GetMaintenanceList triggers the server to emit the maintenance list event, which is handled by
+// the maintenanceList event handler.
+func GetMaintenanceList(ctx context.Context, c StatefulEmiter) error {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(getMaintenanceListAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, getMaintenanceListAction)
+	if err != nil {
+		return wrapErrActionFailed(getMaintenanceListAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &getMaintenanceListResponse{}
+	if err := decode(response, data); err != nil {
+		return wrapErrActionFailed(getMaintenanceListAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return newErrResponse(getMaintenanceListAction, response)
+	}
+
+	return nil
+}
+
+// GetMaintenance requests the data of a specific maintenance from the Uptime Kuma instance. The
+// maintenance is also stored or updated in the client state.
+func GetMaintenance(ctx context.Context, c StatefulEmiter, maintenanceId int) (*state.Maintenance, error) {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return nil, wrapErrActionFailed(getMaintenanceAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, getMaintenanceAction, maintenanceId)
+	if err != nil {
+		return nil, wrapErrActionFailed(getMaintenanceAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &getMaintenanceResponse{}
+	if err := decode(response, data); err != nil {
+		return nil, wrapErrActionFailed(getMaintenanceAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return nil, newErrResponse(getMaintenanceAction, response)
+	}
+
+	// check if maintenance is present
+	if data.Maintenance == nil {
+		return nil, wrapErrActionFailed(getMaintenanceAction, ErrInvalidResponse)
+	}
+
+	// update state
+	if err := c.State().SetMaintenance(maintenanceId, data.Maintenance); err != nil {
+		return nil, wrapErrActionFailed(getMaintenanceAction, err)
+	}
+
+	return data.Maintenance, nil
+}
+
+// AddMaintenance adds a new maintenance to the Uptime Kuma instance and returns its id. The
+// maintenance is validated before it is sent, see ValidateMaintenance.
+func AddMaintenance(ctx context.Context, c StatefulEmiter, maintenance *state.Maintenance) (int, error) {
+	return saveMaintenance(ctx, c, addMaintenanceAction, maintenance)
+}
+
+// EditMaintenance edits the maintenance with the id of the given maintenance. The maintenance is
+// validated before it is sent, see ValidateMaintenance.
+func EditMaintenance(ctx context.Context, c StatefulEmiter, maintenance *state.Maintenance) error {
+	if maintenance != nil && maintenance.Id == 0 {
+		return wrapErrActionFailed(editMaintenanceAction, fmt.Errorf("%w: maintenance id is required", ErrValidation))
+	}
+
+	_, err := saveMaintenance(ctx, c, editMaintenanceAction, maintenance)
+
+	return err
+}
+
+// saveMaintenance calls the given add or edit action with the maintenance and returns its id.
+func saveMaintenance(ctx context.Context, c StatefulEmiter, action string, maintenance *state.Maintenance) (int, error) {
+	// validate maintenance before sending it
+	if err := ValidateMaintenance(maintenance); err != nil {
+		return 0, wrapErrActionFailed(action, err)
+	}
+
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return 0, wrapErrActionFailed(action, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, action, maintenance)
+	if err != nil {
+		return 0, wrapErrActionFailed(action, err)
+	}
+
+	// unmarshal raw response data
+	data := &saveMaintenanceResponse{}
+	if err := decode(response, data); err != nil {
+		return 0, wrapErrActionFailed(action, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return 0, newErrResponse(action, response)
+	}
+
+	// check if maintenance id is present
+	if data.MaintenanceId == nil {
+		return 0, wrapErrActionFailed(action, ErrInvalidResponse)
+	}
+
+	return *data.MaintenanceId, nil
+}
+
+// DeleteMaintenance deletes the maintenance with the given id.
+func DeleteMaintenance(ctx context.Context, c StatefulEmiter, maintenanceId int) error {
+	return callMaintenanceAction(ctx, c, deleteMaintenanceAction, maintenanceId)
+}
+
+// PauseMaintenance pauses the maintenance with the given id, it won't become active until resumed.
+func PauseMaintenance(ctx context.Context, c StatefulEmiter, maintenanceId int) error {
+	return callMaintenanceAction(ctx, c, pauseMaintenanceAction, maintenanceId)
+}
+
+// ResumeMaintenance resumes the paused maintenance with the given id.
+func ResumeMaintenance(ctx context.Context, c StatefulEmiter, maintenanceId int) error {
+	return callMaintenanceAction(ctx, c, resumeMaintenanceAction, maintenanceId)
+}
+
+// callMaintenanceAction calls an action that only takes the id of a maintenance and returns a
+// status.
+func callMaintenanceAction(ctx context.Context, c StatefulEmiter, action string, maintenanceId int, args ...any) error {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(action, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, action, append([]any{maintenanceId}, args...)...)
+	if err != nil {
+		return wrapErrActionFailed(action, err)
+	}
+
+	// unmarshal raw response data
+	data := &maintenanceStatusResponse{}
+	if err := decode(response, data); err != nil {
+		return wrapErrActionFailed(action, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return newErrResponse(action, response)
+	}
+
+	return nil
+}
+
+// AddMonitorMaintenance sets the monitors affected by the maintenance with the given id. Monitors
+// assigned before but missing in monitorIds are removed from the maintenance.
+func AddMonitorMaintenance(ctx context.Context, c StatefulEmiter, maintenanceId int, monitorIds []int) error {
+	return callMaintenanceAction(ctx, c, addMonitorMaintenanceAction, maintenanceId, newIdList(monitorIds))
+}
+
+// GetMonitorMaintenance returns the ids of the monitors affected by the maintenance with the given
+// id.
+func GetMonitorMaintenance(ctx context.Context, c StatefulEmiter, maintenanceId int) ([]int, error) {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return nil, wrapErrActionFailed(getMonitorMaintenanceAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, getMonitorMaintenanceAction, maintenanceId)
+	if err != nil {
+		return nil, wrapErrActionFailed(getMonitorMaintenanceAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &getMonitorMaintenanceResponse{}
+	if err := decode(response, data); err != nil {
+		return nil, wrapErrActionFailed(getMonitorMaintenanceAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return nil, newErrResponse(getMonitorMaintenanceAction, response)
+	}
+
+	monitorIds := make([]int, len(data.Monitors))
+	for i, monitor := range data.Monitors {
+		monitorIds[i] = monitor.Id
+	}
+
+	return monitorIds, nil
+}
+
+// AddMaintenanceStatusPage sets the status pages showing the maintenance with the given id. Status
+// pages assigned before but missing in statusPageIds are removed from the maintenance.
+func AddMaintenanceStatusPage(ctx context.Context, c StatefulEmiter, maintenanceId int, statusPageIds []int) error {
+	return callMaintenanceAction(ctx, c, addMaintenanceStatusPageAction, maintenanceId, newIdList(statusPageIds))
+}
+
+// GetMaintenanceStatusPage returns the ids of the status pages showing the maintenance with the
+// given id.
+func GetMaintenanceStatusPage(ctx context.Context, c StatefulEmiter, maintenanceId int) ([]int, error) {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return nil, wrapErrActionFailed(getMaintenanceStatusPageAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, getMaintenanceStatusPageAction, maintenanceId)
+	if err != nil {
+		return nil, wrapErrActionFailed(getMaintenanceStatusPageAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &getMaintenanceStatusPageResponse{}
+	if err := decode(response, data); err != nil {
+		return nil, wrapErrActionFailed(getMaintenanceStatusPageAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return nil, newErrResponse(getMaintenanceStatusPageAction, response)
+	}
+
+	statusPageIds := make([]int, len(data.StatusPages))
+	for i, statusPage := range data.StatusPages {
+		statusPageIds[i] = statusPage.Id
+	}
+
+	return statusPageIds, nil
+}
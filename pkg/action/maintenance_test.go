@@ -0,0 +1,94 @@
+package action
+
+import (
+	"testing"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMaintenance(t *testing.T) {
+	timeRange := []state.MaintenanceTime{{Hours: 2}, {Hours: 3}}
+
+	tests := []struct {
+		name        string
+		maintenance *state.Maintenance
+		want        *string
+	}{
+		{
+			name:        "nil",
+			maintenance: nil,
+			want:        utils.NewString("maintenance is nil"),
+		},
+		{
+			name:        "missing title",
+			maintenance: &state.Maintenance{Strategy: state.MaintenanceStrategyManual},
+			want:        utils.NewString("maintenance title is required"),
+		},
+		{
+			name:        "manual",
+			maintenance: &state.Maintenance{Title: "manual", Strategy: state.MaintenanceStrategyManual},
+		},
+		{
+			name: "single",
+			maintenance: &state.Maintenance{
+				Title:     "single",
+				Strategy:  state.MaintenanceStrategySingle,
+				DateRange: []string{"2023-10-01 02:00", "2023-10-01 03:00"},
+			},
+		},
+		{
+			name:        "single without end date",
+			maintenance: &state.Maintenance{Title: "single", Strategy: state.MaintenanceStrategySingle, DateRange: []string{"2023-10-01 02:00"}},
+			want:        utils.NewString("single maintenance requires a start and end date"),
+		},
+		{
+			name:        "recurring interval",
+			maintenance: &state.Maintenance{Title: "interval", Strategy: state.MaintenanceStrategyRecurringInterval, IntervalDay: 7, TimeRange: timeRange},
+		},
+		{
+			name:        "recurring interval without interval",
+			maintenance: &state.Maintenance{Title: "interval", Strategy: state.MaintenanceStrategyRecurringInterval, TimeRange: timeRange},
+			want:        utils.NewString("intervalDay of maintenance must be between 1 and 3650"),
+		},
+		{
+			name:        "recurring weekday without time range",
+			maintenance: &state.Maintenance{Title: "weekday", Strategy: state.MaintenanceStrategyRecurringWeekday, Weekdays: []int{1}},
+			want:        utils.NewString("recurring-weekday maintenance requires a start and end time"),
+		},
+		{
+			name:        "recurring day of month without days",
+			maintenance: &state.Maintenance{Title: "day of month", Strategy: state.MaintenanceStrategyRecurringDayOfMonth, TimeRange: timeRange},
+			want:        utils.NewString("recurring-day-of-month maintenance requires days of month"),
+		},
+		{
+			name:        "cron",
+			maintenance: &state.Maintenance{Title: "cron", Strategy: state.MaintenanceStrategyCron, Cron: "30 3 * * *", DurationMinutes: 60},
+		},
+		{
+			name:        "cron without duration",
+			maintenance: &state.Maintenance{Title: "cron", Strategy: state.MaintenanceStrategyCron, Cron: "30 3 * * *"},
+			want:        utils.NewString("cron maintenance requires a duration"),
+		},
+		{
+			name:        "unknown strategy",
+			maintenance: &state.Maintenance{Title: "unknown", Strategy: "weekly"},
+			want:        utils.NewString(`unknown maintenance strategy "weekly"`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMaintenance(tt.maintenance)
+
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, ErrValidation)
+			assert.ErrorContains(t, err, *tt.want)
+		})
+	}
+}
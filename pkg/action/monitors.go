@@ -1,7 +1,7 @@
 package action
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/nobbs/uptime-kuma-api/pkg/handler"
 	"github.com/nobbs/uptime-kuma-api/pkg/state"
@@ -104,27 +104,27 @@ type deleteMonitorTagResponse struct {
 // GetMonitorList triggers the server to emit the monitor list event. The monitor list event
 // contains a list of all monitors in the Uptime Kuma instance and is handled by the monitorList
 // event handler.
-func GetMonitorList(c StatefulEmiter) error {
+func GetMonitorList(ctx context.Context, c StatefulEmiter) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(getMonitorListAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(getMonitorListAction, defaultEmitTimeout)
+	response, err := c.Emit(ctx, getMonitorListAction)
 	if err != nil {
-		return NewErrActionFailed(getMonitorListAction, err.Error())
+		return wrapErrActionFailed(getMonitorListAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &getMonitorListResponse{}
 	if err := decode(response, data); err != nil {
-		return NewErrActionFailed(getMonitorListAction, err.Error())
+		return wrapErrActionFailed(getMonitorListAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return NewErrActionFailed(getMonitorListAction, *data.Msg)
+		return newErrResponse(getMonitorListAction, response)
 	}
 
 	return nil
@@ -132,167 +132,194 @@ func GetMonitorList(c StatefulEmiter) error {
 
 // GetMonitor requests the data of a specific monitor from the Uptime Kuma instance that is
 // send as a response. The monitor data is also stored or updated in the client state.
-func GetMonitor(c StatefulEmiter, monitorId int) (*state.Monitor, error) {
+func GetMonitor(ctx context.Context, c StatefulEmiter, monitorId int) (*state.Monitor, error) {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return nil, NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return nil, wrapErrActionFailed(getMonitorAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(getMonitorAction, defaultEmitTimeout, monitorId)
+	response, err := c.Emit(ctx, getMonitorAction, monitorId)
 	if err != nil {
-		return nil, NewErrActionFailed(getMonitorAction, err.Error())
+		return nil, wrapErrActionFailed(getMonitorAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &getMonitorResponse{}
 	if err := decode(response, data); err != nil {
-		return nil, NewErrActionFailed(getMonitorAction, err.Error())
+		return nil, wrapErrActionFailed(getMonitorAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return nil, NewErrActionFailed(getMonitorAction, *data.Msg)
+		return nil, newErrResponse(getMonitorAction, response)
+	}
+
+	// check if monitor is present
+	if data.Monitor == nil {
+		return nil, wrapErrActionFailed(getMonitorAction, ErrInvalidResponse)
 	}
 
 	// update state
 	if err := c.State().SetMonitor(monitorId, data.Monitor); err != nil {
-		return nil, err
+		return nil, wrapErrActionFailed(getMonitorAction, err)
 	}
 
 	return data.Monitor, nil
 }
 
-// AddMonitor adds a new monitor to the Uptime Kuma instance.
-func AddMonitor(c StatefulEmiter, monitor *state.Monitor) (int, error) {
+// AddMonitor adds a new monitor to the Uptime Kuma instance. The game of gamedig monitors is
+// validated against the game list, see GetGameList.
+func AddMonitor(ctx context.Context, c StatefulEmiter, monitor *state.Monitor) (int, error) {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return 0, NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return 0, wrapErrActionFailed(addMonitorAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// reject unknown games before sending the monitor
+	if err := validateMonitorGame(ctx, c, monitor); err != nil {
+		return 0, wrapErrActionFailed(addMonitorAction, err)
 	}
 
 	// call action
-	response, err := c.Emit(addMonitorAction, defaultEmitTimeout, monitor)
+	response, err := c.Emit(ctx, addMonitorAction, monitor)
 	if err != nil {
-		return 0, NewErrActionFailed(addMonitorAction, err.Error())
+		return 0, wrapErrActionFailed(addMonitorAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &addMonitorResponse{}
 	if err := decode(response, data); err != nil {
-		return 0, NewErrActionFailed(addMonitorAction, err.Error())
+		return 0, wrapErrActionFailed(addMonitorAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return 0, NewErrActionFailed(addMonitorAction, *data.Msg)
+		return 0, newErrResponse(addMonitorAction, response)
+	}
+
+	// check if monitor id is present
+	if data.MonitorId == nil {
+		return 0, wrapErrActionFailed(addMonitorAction, ErrInvalidResponse)
 	}
 
 	return *data.MonitorId, nil
 }
 
-// EditMonitor edits an existing monitor in the Uptime Kuma instance.
-func EditMonitor(c StatefulEmiter, monitor *state.Monitor) (int, error) {
+// EditMonitor edits an existing monitor in the Uptime Kuma instance. The game of gamedig monitors
+// is validated against the game list, see GetGameList.
+func EditMonitor(ctx context.Context, c StatefulEmiter, monitor *state.Monitor) (int, error) {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return 0, NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return 0, wrapErrActionFailed(editMonitorAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// reject unknown games before sending the monitor
+	if err := validateMonitorGame(ctx, c, monitor); err != nil {
+		return 0, wrapErrActionFailed(editMonitorAction, err)
 	}
 
 	// call action
-	response, err := c.Emit(editMonitorAction, defaultEmitTimeout, monitor)
+	response, err := c.Emit(ctx, editMonitorAction, monitor)
 	if err != nil {
-		return 0, NewErrActionFailed(editMonitorAction, err.Error())
+		return 0, wrapErrActionFailed(editMonitorAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &editMonitorResponse{}
 	if err := decode(response, data); err != nil {
-		return 0, NewErrActionFailed(editMonitorAction, err.Error())
+		return 0, wrapErrActionFailed(editMonitorAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return 0, NewErrActionFailed(editMonitorAction, *data.Msg)
+		return 0, newErrResponse(editMonitorAction, response)
+	}
+
+	// check if monitor id is present
+	if data.MonitorId == nil {
+		return 0, wrapErrActionFailed(editMonitorAction, ErrInvalidResponse)
 	}
 
 	return *data.MonitorId, nil
 }
 
 // PauseMonitor pauses a monitor in the Uptime Kuma instance.
-func PauseMonitor(c StatefulEmiter, monitorId int) error {
+func PauseMonitor(ctx context.Context, c StatefulEmiter, monitorId int) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(pauseMonitorAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(pauseMonitorAction, defaultEmitTimeout, monitorId)
+	response, err := c.Emit(ctx, pauseMonitorAction, monitorId)
 	if err != nil {
-		return NewErrActionFailed(pauseMonitorAction, err.Error())
+		return wrapErrActionFailed(pauseMonitorAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &pauseMonitorResponse{}
 	if err := decode(response, data); err != nil {
-		return NewErrActionFailed(pauseMonitorAction, err.Error())
+		return wrapErrActionFailed(pauseMonitorAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return NewErrActionFailed(pauseMonitorAction, *data.Msg)
+		return newErrResponse(pauseMonitorAction, response)
 	}
 
 	return nil
 }
 
 // ResumeMonitor resumes a monitor in the Uptime Kuma instance.
-func ResumeMonitor(c StatefulEmiter, monitorId int) error {
+func ResumeMonitor(ctx context.Context, c StatefulEmiter, monitorId int) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(resumeMonitorAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(resumeMonitorAction, defaultEmitTimeout, monitorId)
+	response, err := c.Emit(ctx, resumeMonitorAction, monitorId)
 	if err != nil {
-		return NewErrActionFailed(resumeMonitorAction, err.Error())
+		return wrapErrActionFailed(resumeMonitorAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &resumeMonitorResponse{}
 	if err := decode(response, data); err != nil {
-		return NewErrActionFailed(resumeMonitorAction, err.Error())
+		return wrapErrActionFailed(resumeMonitorAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return NewErrActionFailed(resumeMonitorAction, *data.Msg)
+		return newErrResponse(resumeMonitorAction, response)
 	}
 
 	return nil
 }
 
 // DeleteMonitor deletes a monitor in the Uptime Kuma instance.
-func DeleteMonitor(c StatefulEmiter, monitorId int) error {
+func DeleteMonitor(ctx context.Context, c StatefulEmiter, monitorId int) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(deleteMonitorAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(deleteMonitorAction, defaultEmitTimeout, monitorId)
+	response, err := c.Emit(ctx, deleteMonitorAction, monitorId)
 	if err != nil {
-		return fmt.Errorf("%s: %w", deleteMonitorAction, err)
+		return wrapErrActionFailed(deleteMonitorAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &deleteMonitorResponse{}
 	if err := decode(response, data); err != nil {
-		return fmt.Errorf("%s: %w", deleteMonitorAction, err)
+		return wrapErrActionFailed(deleteMonitorAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return NewErrActionFailed(deleteMonitorAction, *data.Msg)
+		return newErrResponse(deleteMonitorAction, response)
 	}
 
 	return nil
@@ -300,189 +327,189 @@ func DeleteMonitor(c StatefulEmiter, monitorId int) error {
 
 // GetMonitorBeats requests the heartbeats of a specific monitor and period of hours from the Uptime
 // Kuma instance that are send as a response.
-func GetMonitorBeats(c StatefulEmiter, monitorId int, hours int) ([]state.Heartbeat, error) {
+func GetMonitorBeats(ctx context.Context, c StatefulEmiter, monitorId int, hours int) ([]state.Heartbeat, error) {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return nil, NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return nil, wrapErrActionFailed(getMonitorBeatsAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(getMonitorBeatsAction, defaultEmitTimeout, monitorId, hours)
+	response, err := c.Emit(ctx, getMonitorBeatsAction, monitorId, hours)
 	if err != nil {
-		return nil, NewErrActionFailed(getMonitorBeatsAction, err.Error())
+		return nil, wrapErrActionFailed(getMonitorBeatsAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &getMonitorBeatsResponse{}
 	if err := decode(response, data); err != nil {
-		return nil, NewErrActionFailed(getMonitorBeatsAction, err.Error())
+		return nil, wrapErrActionFailed(getMonitorBeatsAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return nil, NewErrActionFailed(getMonitorBeatsAction, *data.Msg)
+		return nil, newErrResponse(getMonitorBeatsAction, response)
 	}
 
 	return data.Data, nil
 }
 
 // ClearEvents clears the events of a monitor in the Uptime Kuma instance.
-func ClearEvents(c StatefulEmiter, monitorId int) error {
+func ClearEvents(ctx context.Context, c StatefulEmiter, monitorId int) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(clearEventsAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(clearEventsAction, defaultEmitTimeout, monitorId)
+	response, err := c.Emit(ctx, clearEventsAction, monitorId)
 	if err != nil {
-		return NewErrActionFailed(clearEventsAction, err.Error())
+		return wrapErrActionFailed(clearEventsAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &clearEventsResponse{}
 	if err := decode(response, data); err != nil {
-		return NewErrActionFailed(clearEventsAction, err.Error())
+		return wrapErrActionFailed(clearEventsAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return NewErrActionFailed(clearEventsAction, *data.Msg)
+		return newErrResponse(clearEventsAction, response)
 	}
 
 	return nil
 }
 
 // ClearHeartbeats clears the heartbeats of a monitor in the Uptime Kuma instance.
-func ClearHeartbeats(c StatefulEmiter, monitorId int) error {
+func ClearHeartbeats(ctx context.Context, c StatefulEmiter, monitorId int) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(clearHeartbeatsAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(clearHeartbeatsAction, defaultEmitTimeout, monitorId)
+	response, err := c.Emit(ctx, clearHeartbeatsAction, monitorId)
 	if err != nil {
-		return NewErrActionFailed(clearHeartbeatsAction, err.Error())
+		return wrapErrActionFailed(clearHeartbeatsAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &clearHeartbeatsResponse{}
 	if err := decode(response, data); err != nil {
-		return NewErrActionFailed(clearHeartbeatsAction, err.Error())
+		return wrapErrActionFailed(clearHeartbeatsAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return NewErrActionFailed(clearHeartbeatsAction, *data.Msg)
+		return newErrResponse(clearHeartbeatsAction, response)
 	}
 
 	return nil
 }
 
 // ClearStatistics clears the events and heartbeats of all monitors in the Uptime Kuma instance.
-func ClearStatistics(c StatefulEmiter) error {
+func ClearStatistics(ctx context.Context, c StatefulEmiter) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(clearStatisticsAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(clearStatisticsAction, defaultEmitTimeout)
+	response, err := c.Emit(ctx, clearStatisticsAction)
 	if err != nil {
-		return NewErrActionFailed(clearStatisticsAction, err.Error())
+		return wrapErrActionFailed(clearStatisticsAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &clearStatisticsResponse{}
 	if err := decode(response, data); err != nil {
-		return NewErrActionFailed(clearStatisticsAction, err.Error())
+		return wrapErrActionFailed(clearStatisticsAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return NewErrActionFailed(clearStatisticsAction, *data.Msg)
+		return newErrResponse(clearStatisticsAction, response)
 	}
 
 	return nil
 }
 
 // AddMonitorTag adds a tag to a monitor in the Uptime Kuma instance with the given value.
-func AddMonitorTag(c StatefulEmiter, monitorId, tagId int, value string) error {
+func AddMonitorTag(ctx context.Context, c StatefulEmiter, monitorId, tagId int, value string) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return fmt.Errorf("%s: %w", handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(addMonitorTagAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(addMonitorTagAction, defaultEmitTimeout, tagId, monitorId, value)
+	response, err := c.Emit(ctx, addMonitorTagAction, tagId, monitorId, value)
 	if err != nil {
-		return fmt.Errorf("%s: %w", addMonitorTagAction, err)
+		return wrapErrActionFailed(addMonitorTagAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &addMonitorTagResponse{}
 	if err := decode(response, data); err != nil {
-		return fmt.Errorf("%s: %w", addMonitorTagAction, err)
+		return wrapErrActionFailed(addMonitorTagAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return NewErrActionFailed(addMonitorTagAction, *data.Msg)
+		return newErrResponse(addMonitorTagAction, response)
 	}
 
 	return nil
 }
 
 // EditMonitorTag edits a tag of a monitor in the Uptime Kuma instance with the given value.
-func EditMonitorTag(c StatefulEmiter, monitorId, tagId int, value string) error {
+func EditMonitorTag(ctx context.Context, c StatefulEmiter, monitorId, tagId int, value string) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return fmt.Errorf("%s: %w", handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(editMonitorTagAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(editMonitorTagAction, defaultEmitTimeout, tagId, monitorId, value)
+	response, err := c.Emit(ctx, editMonitorTagAction, tagId, monitorId, value)
 	if err != nil {
-		return fmt.Errorf("%s: %w", editMonitorTagAction, err)
+		return wrapErrActionFailed(editMonitorTagAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &editMonitorTagResponse{}
 	if err := decode(response, data); err != nil {
-		return fmt.Errorf("%s: %w", editMonitorTagAction, err)
+		return wrapErrActionFailed(editMonitorTagAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return NewErrActionFailed(editMonitorTagAction, *data.Msg)
+		return newErrResponse(editMonitorTagAction, response)
 	}
 
 	return nil
 }
 
 // DeleteMonitorTag deletes a tag of a monitor in the Uptime Kuma instance.
-func DeleteMonitorTag(c StatefulEmiter, monitorId, tagId int, value string) error {
+func DeleteMonitorTag(ctx context.Context, c StatefulEmiter, monitorId, tagId int, value string) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return fmt.Errorf("%s: %w", handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(deleteMonitorTagAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(deleteMonitorTagAction, defaultEmitTimeout, tagId, monitorId, value)
+	response, err := c.Emit(ctx, deleteMonitorTagAction, tagId, monitorId, value)
 	if err != nil {
-		return fmt.Errorf("%s: %w", deleteMonitorTagAction, err)
+		return wrapErrActionFailed(deleteMonitorTagAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &deleteMonitorTagResponse{}
 	if err := decode(response, data); err != nil {
-		return fmt.Errorf("%s: %w", deleteMonitorTagAction, err)
+		return wrapErrActionFailed(deleteMonitorTagAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return NewErrActionFailed(deleteMonitorTagAction, *data.Msg)
+		return newErrResponse(deleteMonitorTagAction, response)
 	}
 
 	return nil
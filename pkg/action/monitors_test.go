@@ -0,0 +1,131 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const getMonitorResponseJSON = `{
+	"ok": true,
+	"monitor": {
+		"id": 7,
+		"name": "Orders",
+		"type": "kafka-producer",
+		"active": true,
+		"interval": 60,
+		"timeout": 48,
+		"resendInterval": 10,
+		"pathName": "Shop / Orders",
+		"childrenIDs": [],
+		"tags": [{"id": 1, "monitor_id": 7, "tag_id": 2, "value": "prod", "name": "env", "color": "#000000"}],
+		"notificationIDList": {"1": true},
+		"headers": "{\"X-Test\": \"1\"}",
+		"jsonPath": "$.status",
+		"expectedValue": "ok",
+		"kafkaProducerTopic": "orders",
+		"kafkaProducerBrokers": ["kafka:9092"],
+		"kafkaProducerSsl": true,
+		"kafkaProducerSaslOptions": {"mechanism": "plain", "username": "user", "password": "secret"},
+		"oauth_client_id": "client",
+		"oauth_token_url": "https://auth.example.com/token",
+		"screenshot": "/screenshots/7.png",
+		"remote_browser": 3,
+		"tlsExpiryNotification": true,
+		"mqttCheckType": "keyword"
+	}
+}`
+
+func TestMonitor_Decode(t *testing.T) {
+	data := &getMonitorResponse{}
+	assert.NoError(t, decode([]any{[]byte(getMonitorResponseJSON)}, data))
+
+	m := data.Monitor
+	assert.Equal(t, 7, m.Id)
+	assert.Equal(t, utils.NewFloat64(48), m.Timeout)
+	assert.Equal(t, 10, m.ResendInterval)
+	assert.Equal(t, map[int]bool{1: true}, m.NotificationIDList)
+	assert.Equal(t, utils.NewString(`{"X-Test": "1"}`), m.Headers)
+	assert.Equal(t, utils.NewString("$.status"), m.JsonPath)
+	assert.Equal(t, []string{"kafka:9092"}, m.KafkaProducerBrokers)
+	assert.Equal(t, &state.KafkaProducerSaslOptions{
+		Mechanism: "plain",
+		Username:  utils.NewString("user"),
+		Password:  utils.NewString("secret"),
+	}, m.KafkaProducerSaslOptions)
+	assert.Equal(t, utils.NewString("client"), m.OauthClientId)
+	assert.Equal(t, utils.NewString("/screenshots/7.png"), m.Screenshot)
+	assert.Equal(t, utils.NewInt(3), m.RemoteBrowser)
+	assert.Equal(t, utils.NewBool(true), m.TlsExpiryNotification)
+	assert.Equal(t, []state.MonitorTag{{Id: 1, MonitorId: 7, TagId: 2, Value: "prod", Name: "env", Color: "#000000"}}, m.Tags)
+	assert.Equal(t, map[string]any{"mqttCheckType": "keyword"}, m.Unmapped)
+}
+
+func TestEditMonitor_RoundTrip(t *testing.T) {
+	s := state.NewState()
+
+	var sent map[string]any
+
+	c := mocks.NewStatefulEmiter(t)
+	c.EXPECT().State().Return(s)
+	c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(nil)
+	c.EXPECT().Emit(mock.Anything, getMonitorAction, 7).Return([]any{[]byte(getMonitorResponseJSON)}, nil).Once()
+	c.EXPECT().Emit(mock.Anything, editMonitorAction, mock.MatchedBy(func(m *state.Monitor) bool {
+		raw, err := json.Marshal(m)
+		if err != nil {
+			return false
+		}
+
+		return json.Unmarshal(raw, &sent) == nil
+	})).Return([]any{[]byte(`{"ok":true,"monitorID":7}`)}, nil).Once()
+
+	monitor, err := GetMonitor(context.Background(), c, 7)
+	assert.NoError(t, err)
+
+	_, err = EditMonitor(context.Background(), c, monitor)
+	assert.NoError(t, err)
+
+	// the id, the new fields and unknown fields are sent back
+	assert.Equal(t, 7.0, sent["id"])
+	assert.Equal(t, 48.0, sent["timeout"])
+	assert.Equal(t, "$.status", sent["jsonPath"])
+	assert.Equal(t, "ok", sent["expectedValue"])
+	assert.Equal(t, []any{"kafka:9092"}, sent["kafkaProducerBrokers"])
+	assert.Equal(t, map[string]any{"mechanism": "plain", "username": "user", "password": "secret"}, sent["kafkaProducerSaslOptions"])
+	assert.Equal(t, "https://auth.example.com/token", sent["oauth_token_url"])
+	assert.Equal(t, 3.0, sent["remote_browser"])
+	assert.Equal(t, map[string]any{"1": true}, sent["notificationIDList"])
+	assert.Equal(t, "keyword", sent["mqttCheckType"])
+
+	// read-only fields are not sent
+	for _, key := range []string{"active", "pathName", "tags", "screenshot", "childrenIds"} {
+		assert.NotContains(t, sent, key)
+	}
+}
+
+func TestMonitor_MarshalJSON(t *testing.T) {
+	// fields of newer versions are omitted if not set, unmapped fields never override mapped ones
+	raw, err := json.Marshal(&state.Monitor{
+		Name:     "API",
+		Type:     "http",
+		Unmapped: map[string]any{"name": "other", "cacheBust": true},
+	})
+	assert.NoError(t, err)
+
+	got := map[string]any{}
+	assert.NoError(t, json.Unmarshal(raw, &got))
+
+	assert.Equal(t, "API", got["name"])
+	assert.Equal(t, true, got["cacheBust"])
+	assert.NotContains(t, got, "id")
+	assert.NotContains(t, got, "timeout")
+	assert.NotContains(t, got, "kafkaProducerSaslOptions")
+	assert.NotContains(t, got, "conditions")
+}
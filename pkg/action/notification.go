@@ -0,0 +1,296 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+const (
+	addNotificationAction    = "addNotification"
+	deleteNotificationAction = "deleteNotification"
+	testNotificationAction   = "testNotification"
+	checkAppriseAction       = "checkApprise"
+)
+
+// Notification is the configuration of a notification as sent with the addNotification and
+// testNotification actions. Uptime Kuma stores a notification as a flat object, so the provider
+// specific fields, e.g. webhookURL for the webhook provider, are sent alongside the common fields.
+type Notification struct {
+	Name string
+
+	// Type is the provider type, e.g. "webhook". It is taken from the Provider if one is set.
+	Type      string
+	IsDefault bool
+
+	// ApplyExisting enables the notification for all existing monitors when saving it.
+	ApplyExisting bool
+
+	// Provider is the typed configuration of the provider. Its fields take precedence over Fields.
+	Provider NotificationProvider
+
+	// Fields holds the provider specific fields, e.g. for providers without a typed configuration.
+	Fields map[string]any
+}
+
+// NewNotification returns a new notification with the given name, using the typed configuration of
+// a provider. If the provider is nil, the type is left empty and reported by Validate.
+func NewNotification(name string, provider NotificationProvider) *Notification {
+	if provider == nil {
+		return &Notification{Name: name}
+	}
+
+	return &Notification{Name: name, Type: provider.Type(), Provider: provider}
+}
+
+// NotificationFromState returns the configuration of a notification received from Uptime Kuma, e.g.
+// to edit it. The provider specific fields are decoded into the typed configuration of the provider,
+// if there is one, and into Fields otherwise.
+func NotificationFromState(n *state.Notification) (*Notification, error) {
+	config, err := n.DecodeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	notification := &Notification{
+		Name:      n.Name,
+		IsDefault: n.IsDefault,
+		Fields:    config,
+	}
+
+	if t, ok := config["type"].(string); ok {
+		notification.Type = t
+	}
+
+	// the common fields are stored in the config as well, remove them from the provider fields
+	for _, key := range []string{"id", "name", "type", "isDefault", "applyExisting"} {
+		delete(notification.Fields, key)
+	}
+
+	provider, ok, err := DecodeNotificationProvider(notification.Type, notification.Fields)
+	if err != nil {
+		return nil, err
+	} else if ok {
+		notification.Provider = provider
+		notification.Fields = nil
+	}
+
+	return notification, nil
+}
+
+// Validate returns an error wrapping ErrValidation if the type is missing or if the typed
+// configuration of the provider is invalid.
+func (n *Notification) Validate() error {
+	if n.Provider == nil {
+		if n.Type == "" {
+			return fmt.Errorf("%w: notification type is required", ErrValidation)
+		}
+
+		return nil
+	}
+
+	if n.Type != "" && n.Type != n.Provider.Type() {
+		return fmt.Errorf("%w: notification type %q does not match provider type %q", ErrValidation, n.Type, n.Provider.Type())
+	}
+
+	return n.Provider.Validate()
+}
+
+// MarshalJSON encodes the notification as the flat object expected by Uptime Kuma. The common
+// fields take precedence over provider fields with the same key.
+func (n Notification) MarshalJSON() ([]byte, error) {
+	payload := make(map[string]any, len(n.Fields)+4)
+	for key, value := range n.Fields {
+		payload[key] = value
+	}
+
+	notificationType := n.Type
+
+	if n.Provider != nil {
+		fields, err := utils.EncodeMap(n.Provider)
+		if err != nil {
+			return nil, err
+		}
+
+		for key, value := range fields {
+			payload[key] = value
+		}
+
+		notificationType = n.Provider.Type()
+	}
+
+	payload["name"] = n.Name
+	payload["type"] = notificationType
+	payload["isDefault"] = n.IsDefault
+	payload["applyExisting"] = n.ApplyExisting
+
+	return json.Marshal(payload)
+}
+
+type addNotificationResponse struct {
+	Ok             bool    `mapstructure:"ok"`
+	Msg            *string `mapstructure:"msg"`
+	NotificationId *int    `mapstructure:"id"`
+}
+
+type deleteNotificationResponse struct {
+	Ok  bool    `mapstructure:"ok"`
+	Msg *string `mapstructure:"msg"`
+}
+
+type testNotificationResponse struct {
+	Ok  bool    `mapstructure:"ok"`
+	Msg *string `mapstructure:"msg"`
+}
+
+// AddNotification adds a new notification to the Uptime Kuma instance and returns its id.
+func AddNotification(ctx context.Context, c StatefulEmiter, notification *Notification) (int, error) {
+	return saveNotification(ctx, c, nil, notification)
+}
+
+// EditNotification replaces the configuration of the notification with the given id.
+func EditNotification(ctx context.Context, c StatefulEmiter, notificationId int, notification *Notification) error {
+	_, err := saveNotification(ctx, c, &notificationId, notification)
+	return err
+}
+
+// saveNotification creates a new notification if notificationId is nil, otherwise it edits the
+// existing notification.
+func saveNotification(ctx context.Context, c StatefulEmiter, notificationId *int, notification *Notification) (int, error) {
+	if notification == nil {
+		return 0, wrapErrActionFailed(addNotificationAction, fmt.Errorf("%w: notification is nil", ErrValidation))
+	}
+
+	// validate notification before sending it
+	if notification.Name == "" {
+		return 0, wrapErrActionFailed(addNotificationAction, fmt.Errorf("%w: notification name is required", ErrValidation))
+	}
+
+	if err := notification.Validate(); err != nil {
+		return 0, wrapErrActionFailed(addNotificationAction, err)
+	}
+
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return 0, wrapErrActionFailed(addNotificationAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action, a nil id creates a new notification
+	response, err := c.Emit(ctx, addNotificationAction, notification, notificationId)
+	if err != nil {
+		return 0, wrapErrActionFailed(addNotificationAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &addNotificationResponse{}
+	if err := decode(response, data); err != nil {
+		return 0, wrapErrActionFailed(addNotificationAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return 0, newErrResponse(addNotificationAction, response)
+	}
+
+	// check if notification id is present
+	if data.NotificationId == nil {
+		return 0, wrapErrActionFailed(addNotificationAction, ErrInvalidResponse)
+	}
+
+	return *data.NotificationId, nil
+}
+
+// DeleteNotification deletes the notification with the given id.
+func DeleteNotification(ctx context.Context, c StatefulEmiter, notificationId int) error {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(deleteNotificationAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, deleteNotificationAction, notificationId)
+	if err != nil {
+		return wrapErrActionFailed(deleteNotificationAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &deleteNotificationResponse{}
+	if err := decode(response, data); err != nil {
+		return wrapErrActionFailed(deleteNotificationAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return newErrResponse(deleteNotificationAction, response)
+	}
+
+	return nil
+}
+
+// TestNotification sends a test message using the given notification configuration, which does not
+// need to be saved. Returns the message of the server, e.g. "Sent Successfully.".
+func TestNotification(ctx context.Context, c StatefulEmiter, notification *Notification) (string, error) {
+	if notification == nil {
+		return "", wrapErrActionFailed(testNotificationAction, fmt.Errorf("%w: notification is nil", ErrValidation))
+	}
+
+	// validate notification before sending it
+	if err := notification.Validate(); err != nil {
+		return "", wrapErrActionFailed(testNotificationAction, err)
+	}
+
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return "", wrapErrActionFailed(testNotificationAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, testNotificationAction, notification)
+	if err != nil {
+		return "", wrapErrActionFailed(testNotificationAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &testNotificationResponse{}
+	if err := decode(response, data); err != nil {
+		return "", wrapErrActionFailed(testNotificationAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return "", newErrResponse(testNotificationAction, response)
+	}
+
+	if data.Msg == nil {
+		return "", nil
+	}
+
+	return *data.Msg, nil
+}
+
+// CheckApprise returns true if Apprise is installed on the Uptime Kuma server, which is required
+// for the apprise notification provider.
+func CheckApprise(ctx context.Context, c StatefulEmiter) (bool, error) {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return false, wrapErrActionFailed(checkAppriseAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, checkAppriseAction)
+	if err != nil {
+		return false, wrapErrActionFailed(checkAppriseAction, err)
+	}
+
+	// unmarshal raw response data
+	installed := false
+	if err := decode(response, &installed); err != nil {
+		return false, wrapErrActionFailed(checkAppriseAction, err)
+	}
+
+	return installed, nil
+}
@@ -0,0 +1,390 @@
+package action
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+// NotificationProvider is the typed configuration of a notification provider. Its fields are sent
+// as the provider specific fields of a Notification, see Notification.Provider.
+type NotificationProvider interface {
+	// Type returns the type of the provider as used by Uptime Kuma, e.g. "webhook".
+	Type() string
+
+	// Validate returns an error wrapping ErrValidation if a required field is missing or invalid.
+	Validate() error
+}
+
+// notificationProviders maps the provider types to constructors of their typed configuration.
+var notificationProviders = map[string]func() NotificationProvider{
+	"webhook":   func() NotificationProvider { return &WebhookNotification{} },
+	"slack":     func() NotificationProvider { return &SlackNotification{} },
+	"discord":   func() NotificationProvider { return &DiscordNotification{} },
+	"teams":     func() NotificationProvider { return &TeamsNotification{} },
+	"smtp":      func() NotificationProvider { return &SmtpNotification{} },
+	"telegram":  func() NotificationProvider { return &TelegramNotification{} },
+	"gotify":    func() NotificationProvider { return &GotifyNotification{} },
+	"ntfy":      func() NotificationProvider { return &NtfyNotification{} },
+	"PagerDuty": func() NotificationProvider { return &PagerDutyNotification{} },
+	"Opsgenie":  func() NotificationProvider { return &OpsgenieNotification{} },
+	"matrix":    func() NotificationProvider { return &MatrixNotification{} },
+	"pushover":  func() NotificationProvider { return &PushoverNotification{} },
+}
+
+// DecodeNotificationProvider decodes the provider specific fields of a notification into the typed
+// configuration of the given provider type. Fields without a typed counterpart are kept in the
+// Unmapped field of the configuration. Returns false if there is no typed configuration for the
+// provider type.
+func DecodeNotificationProvider(providerType string, fields map[string]any) (NotificationProvider, bool, error) {
+	newProvider, ok := notificationProviders[providerType]
+	if !ok {
+		return nil, false, nil
+	}
+
+	provider := newProvider()
+	if err := utils.Decode(fields, provider); err != nil {
+		return nil, true, fmt.Errorf("decode %s notification failed: %w", providerType, err)
+	}
+
+	return provider, true, nil
+}
+
+// requireFields returns an error wrapping ErrValidation listing the keys of all empty values.
+func requireFields(providerType string, values map[string]string) error {
+	missing := []string{}
+
+	for key, value := range values {
+		if strings.TrimSpace(value) == "" {
+			missing = append(missing, key)
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+
+	return fmt.Errorf("%w: %s notification requires %s", ErrValidation, providerType, strings.Join(missing, ", "))
+}
+
+// validateRange returns an error wrapping ErrValidation if the value is set and not within lower
+// and upper.
+func validateRange(providerType, key string, value *int, lower, upper int) error {
+	if value == nil || (*value >= lower && *value <= upper) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s of %s notification must be between %d and %d", ErrValidation, key, providerType, lower, upper)
+}
+
+// WebhookNotification sends a HTTP POST request to a custom URL.
+type WebhookNotification struct {
+	WebhookUrl string `mapstructure:"webhookURL" json:"webhookURL"`
+
+	// WebhookContentType is one of "json", "form-data" or "custom".
+	WebhookContentType string `mapstructure:"webhookContentType" json:"webhookContentType,omitempty"`
+
+	// WebhookCustomBody is the body template used with the "custom" content type.
+	WebhookCustomBody        string `mapstructure:"webhookCustomBody" json:"webhookCustomBody,omitempty"`
+	WebhookAdditionalHeaders string `mapstructure:"webhookAdditionalHeaders" json:"webhookAdditionalHeaders,omitempty"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+func (n *WebhookNotification) Type() string {
+	return "webhook"
+}
+
+func (n *WebhookNotification) Validate() error {
+	if err := requireFields(n.Type(), map[string]string{"webhookURL": n.WebhookUrl}); err != nil {
+		return err
+	}
+
+	switch n.WebhookContentType {
+	case "", "json", "form-data", "custom":
+	default:
+		return fmt.Errorf("%w: unknown webhookContentType %q", ErrValidation, n.WebhookContentType)
+	}
+
+	return nil
+}
+
+// SlackNotification posts to a Slack incoming webhook.
+type SlackNotification struct {
+	SlackWebhookUrl    string `mapstructure:"slackwebhookURL" json:"slackwebhookURL"`
+	SlackUsername      string `mapstructure:"slackusername" json:"slackusername,omitempty"`
+	SlackIconEmoji     string `mapstructure:"slackiconemo" json:"slackiconemo,omitempty"`
+	SlackChannel       string `mapstructure:"slackchannel" json:"slackchannel,omitempty"`
+	SlackChannelNotify *bool  `mapstructure:"slackchannelnotify" json:"slackchannelnotify,omitempty"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+func (n *SlackNotification) Type() string {
+	return "slack"
+}
+
+func (n *SlackNotification) Validate() error {
+	return requireFields(n.Type(), map[string]string{"slackwebhookURL": n.SlackWebhookUrl})
+}
+
+// DiscordNotification posts to a Discord webhook.
+type DiscordNotification struct {
+	DiscordWebhookUrl    string `mapstructure:"discordWebhookUrl" json:"discordWebhookUrl"`
+	DiscordUsername      string `mapstructure:"discordUsername" json:"discordUsername,omitempty"`
+	DiscordPrefixMessage string `mapstructure:"discordPrefixMessage" json:"discordPrefixMessage,omitempty"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+func (n *DiscordNotification) Type() string {
+	return "discord"
+}
+
+func (n *DiscordNotification) Validate() error {
+	return requireFields(n.Type(), map[string]string{"discordWebhookUrl": n.DiscordWebhookUrl})
+}
+
+// TeamsNotification posts to a Microsoft Teams incoming webhook.
+type TeamsNotification struct {
+	WebhookUrl string `mapstructure:"webhookUrl" json:"webhookUrl"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+func (n *TeamsNotification) Type() string {
+	return "teams"
+}
+
+func (n *TeamsNotification) Validate() error {
+	return requireFields(n.Type(), map[string]string{"webhookUrl": n.WebhookUrl})
+}
+
+// SmtpNotification sends an email. At least one of SmtpTo, SmtpCC and SmtpBCC is required.
+type SmtpNotification struct {
+	SmtpHost           string `mapstructure:"smtpHost" json:"smtpHost"`
+	SmtpPort           int    `mapstructure:"smtpPort" json:"smtpPort"`
+	SmtpSecure         *bool  `mapstructure:"smtpSecure" json:"smtpSecure,omitempty"`
+	SmtpIgnoreTLSError *bool  `mapstructure:"smtpIgnoreTLSError" json:"smtpIgnoreTLSError,omitempty"`
+	SmtpUsername       string `mapstructure:"smtpUsername" json:"smtpUsername,omitempty"`
+	SmtpPassword       string `mapstructure:"smtpPassword" json:"smtpPassword,omitempty"`
+	SmtpFrom           string `mapstructure:"smtpFrom" json:"smtpFrom"`
+	SmtpTo             string `mapstructure:"smtpTo" json:"smtpTo,omitempty"`
+	SmtpCC             string `mapstructure:"smtpCC" json:"smtpCC,omitempty"`
+	SmtpBCC            string `mapstructure:"smtpBCC" json:"smtpBCC,omitempty"`
+	CustomSubject      string `mapstructure:"customSubject" json:"customSubject,omitempty"`
+	CustomBody         string `mapstructure:"customBody" json:"customBody,omitempty"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+func (n *SmtpNotification) Type() string {
+	return "smtp"
+}
+
+func (n *SmtpNotification) Validate() error {
+	if err := requireFields(n.Type(), map[string]string{"smtpHost": n.SmtpHost, "smtpFrom": n.SmtpFrom}); err != nil {
+		return err
+	}
+
+	if n.SmtpPort < 1 || n.SmtpPort > 65535 {
+		return fmt.Errorf("%w: smtpPort of smtp notification must be between 1 and 65535", ErrValidation)
+	}
+
+	if n.SmtpTo == "" && n.SmtpCC == "" && n.SmtpBCC == "" {
+		return fmt.Errorf("%w: smtp notification requires one of smtpTo, smtpCC, smtpBCC", ErrValidation)
+	}
+
+	return nil
+}
+
+// TelegramNotification sends a message with a Telegram bot.
+type TelegramNotification struct {
+	TelegramBotToken        string `mapstructure:"telegramBotToken" json:"telegramBotToken"`
+	TelegramChatId          string `mapstructure:"telegramChatID" json:"telegramChatID"`
+	TelegramMessageThreadId string `mapstructure:"telegramMessageThreadID" json:"telegramMessageThreadID,omitempty"`
+	TelegramSendSilently    *bool  `mapstructure:"telegramSendSilently" json:"telegramSendSilently,omitempty"`
+	TelegramProtectContent  *bool  `mapstructure:"telegramProtectContent" json:"telegramProtectContent,omitempty"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+func (n *TelegramNotification) Type() string {
+	return "telegram"
+}
+
+func (n *TelegramNotification) Validate() error {
+	return requireFields(n.Type(), map[string]string{
+		"telegramBotToken": n.TelegramBotToken,
+		"telegramChatID":   n.TelegramChatId,
+	})
+}
+
+// GotifyNotification sends a message to a Gotify server.
+type GotifyNotification struct {
+	GotifyServerUrl        string `mapstructure:"gotifyserverurl" json:"gotifyserverurl"`
+	GotifyApplicationToken string `mapstructure:"gotifyapplicationToken" json:"gotifyapplicationToken"`
+	GotifyPriority         *int   `mapstructure:"gotifyPriority" json:"gotifyPriority,omitempty"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+func (n *GotifyNotification) Type() string {
+	return "gotify"
+}
+
+func (n *GotifyNotification) Validate() error {
+	if err := requireFields(n.Type(), map[string]string{
+		"gotifyserverurl":        n.GotifyServerUrl,
+		"gotifyapplicationToken": n.GotifyApplicationToken,
+	}); err != nil {
+		return err
+	}
+
+	return validateRange(n.Type(), "gotifyPriority", n.GotifyPriority, 0, 10)
+}
+
+// NtfyNotification publishes a message to a ntfy topic.
+type NtfyNotification struct {
+	NtfyServerUrl   string `mapstructure:"ntfyserverurl" json:"ntfyserverurl"`
+	NtfyTopic       string `mapstructure:"ntfytopic" json:"ntfytopic"`
+	NtfyPriority    *int   `mapstructure:"ntfyPriority" json:"ntfyPriority,omitempty"`
+	NtfyUsername    string `mapstructure:"ntfyusername" json:"ntfyusername,omitempty"`
+	NtfyPassword    string `mapstructure:"ntfypassword" json:"ntfypassword,omitempty"`
+	NtfyAccessToken string `mapstructure:"ntfyaccesstoken" json:"ntfyaccesstoken,omitempty"`
+	NtfyIcon        string `mapstructure:"ntfyIcon" json:"ntfyIcon,omitempty"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+func (n *NtfyNotification) Type() string {
+	return "ntfy"
+}
+
+func (n *NtfyNotification) Validate() error {
+	if err := requireFields(n.Type(), map[string]string{
+		"ntfyserverurl": n.NtfyServerUrl,
+		"ntfytopic":     n.NtfyTopic,
+	}); err != nil {
+		return err
+	}
+
+	return validateRange(n.Type(), "ntfyPriority", n.NtfyPriority, 1, 5)
+}
+
+// PagerDutyNotification triggers a PagerDuty incident.
+type PagerDutyNotification struct {
+	PagerDutyIntegrationKey string `mapstructure:"pagerdutyIntegrationKey" json:"pagerdutyIntegrationKey"`
+	PagerDutyIntegrationUrl string `mapstructure:"pagerdutyIntegrationUrl" json:"pagerdutyIntegrationUrl,omitempty"`
+
+	// PagerDutyPriority is one of "info", "warning", "error" or "critical".
+	PagerDutyPriority string `mapstructure:"pagerdutyPriority" json:"pagerdutyPriority,omitempty"`
+
+	// PagerDutyAutoResolve is "0" to keep incidents open, "acknowledge" or "resolve" on recovery.
+	PagerDutyAutoResolve string `mapstructure:"pagerdutyAutoResolve" json:"pagerdutyAutoResolve,omitempty"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+func (n *PagerDutyNotification) Type() string {
+	return "PagerDuty"
+}
+
+func (n *PagerDutyNotification) Validate() error {
+	return requireFields(n.Type(), map[string]string{"pagerdutyIntegrationKey": n.PagerDutyIntegrationKey})
+}
+
+// OpsgenieNotification creates an Opsgenie alert.
+type OpsgenieNotification struct {
+	OpsgenieApiKey string `mapstructure:"opsgenieApiKey" json:"opsgenieApiKey"`
+
+	// OpsgenieRegion is either "us" or "eu".
+	OpsgenieRegion   string `mapstructure:"opsgenieRegion" json:"opsgenieRegion"`
+	OpsgeniePriority *int   `mapstructure:"opsgeniePriority" json:"opsgeniePriority,omitempty"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+func (n *OpsgenieNotification) Type() string {
+	return "Opsgenie"
+}
+
+func (n *OpsgenieNotification) Validate() error {
+	if err := requireFields(n.Type(), map[string]string{
+		"opsgenieApiKey": n.OpsgenieApiKey,
+		"opsgenieRegion": n.OpsgenieRegion,
+	}); err != nil {
+		return err
+	}
+
+	if n.OpsgenieRegion != "us" && n.OpsgenieRegion != "eu" {
+		return fmt.Errorf("%w: unknown opsgenieRegion %q", ErrValidation, n.OpsgenieRegion)
+	}
+
+	return validateRange(n.Type(), "opsgeniePriority", n.OpsgeniePriority, 1, 5)
+}
+
+// MatrixNotification sends a message to a Matrix room.
+type MatrixNotification struct {
+	HomeserverUrl  string `mapstructure:"homeserverUrl" json:"homeserverUrl"`
+	InternalRoomId string `mapstructure:"internalRoomId" json:"internalRoomId"`
+	AccessToken    string `mapstructure:"accessToken" json:"accessToken"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+func (n *MatrixNotification) Type() string {
+	return "matrix"
+}
+
+func (n *MatrixNotification) Validate() error {
+	return requireFields(n.Type(), map[string]string{
+		"homeserverUrl":  n.HomeserverUrl,
+		"internalRoomId": n.InternalRoomId,
+		"accessToken":    n.AccessToken,
+	})
+}
+
+// PushoverNotification sends a Pushover message.
+type PushoverNotification struct {
+	PushoverUserKey  string `mapstructure:"pushoveruserkey" json:"pushoveruserkey"`
+	PushoverAppToken string `mapstructure:"pushoverapptoken" json:"pushoverapptoken"`
+	PushoverSounds   string `mapstructure:"pushoversounds" json:"pushoversounds,omitempty"`
+	PushoverPriority *int   `mapstructure:"pushoverpriority" json:"pushoverpriority,omitempty"`
+	PushoverTitle    string `mapstructure:"pushovertitle" json:"pushovertitle,omitempty"`
+	PushoverDevice   string `mapstructure:"pushoverdevice" json:"pushoverdevice,omitempty"`
+	PushoverTtl      *int   `mapstructure:"pushoverttl" json:"pushoverttl,omitempty"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+func (n *PushoverNotification) Type() string {
+	return "pushover"
+}
+
+func (n *PushoverNotification) Validate() error {
+	if err := requireFields(n.Type(), map[string]string{
+		"pushoveruserkey":  n.PushoverUserKey,
+		"pushoverapptoken": n.PushoverAppToken,
+	}); err != nil {
+		return err
+	}
+
+	return validateRange(n.Type(), "pushoverpriority", n.PushoverPriority, -2, 2)
+}
@@ -0,0 +1,192 @@
+package action
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotification_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name         string
+		notification *Notification
+		want         map[string]any
+	}{
+		{
+			name: "fields",
+			notification: &Notification{
+				Name:   "Apprise",
+				Type:   "apprise",
+				Fields: map[string]any{"appriseURL": "json://example.com"},
+			},
+			want: map[string]any{
+				"name":          "Apprise",
+				"type":          "apprise",
+				"isDefault":     false,
+				"applyExisting": false,
+				"appriseURL":    "json://example.com",
+			},
+		},
+		{
+			name: "provider",
+			notification: &Notification{
+				Name:          "Slack",
+				IsDefault:     true,
+				ApplyExisting: true,
+				Provider: &SlackNotification{
+					SlackWebhookUrl: "https://hooks.slack.com/services/xxx",
+					SlackChannel:    "#alerts",
+					Unmapped:        map[string]any{"slackrichmessage": true},
+				},
+			},
+			want: map[string]any{
+				"name":             "Slack",
+				"type":             "slack",
+				"isDefault":        true,
+				"applyExisting":    true,
+				"slackwebhookURL":  "https://hooks.slack.com/services/xxx",
+				"slackchannel":     "#alerts",
+				"slackrichmessage": true,
+			},
+		},
+		{
+			name: "provider overrides fields",
+			notification: &Notification{
+				Name:     "Teams",
+				Provider: &TeamsNotification{WebhookUrl: "https://example.com/new"},
+				Fields:   map[string]any{"webhookUrl": "https://example.com/old", "type": "webhook"},
+			},
+			want: map[string]any{
+				"name":          "Teams",
+				"type":          "teams",
+				"isDefault":     false,
+				"applyExisting": false,
+				"webhookUrl":    "https://example.com/new",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := json.Marshal(tt.notification)
+			assert.NoError(t, err)
+
+			got := map[string]any{}
+			assert.NoError(t, json.Unmarshal(raw, &got))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNotification_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		notification *Notification
+		want         *string
+	}{
+		{
+			name:         "webhook",
+			notification: NewNotification("Webhook", &WebhookNotification{WebhookUrl: "https://example.com"}),
+		},
+		{
+			name:         "webhook missing url",
+			notification: NewNotification("Webhook", &WebhookNotification{}),
+			want:         utils.NewString("webhook notification requires webhookURL"),
+		},
+		{
+			name:         "webhook invalid content type",
+			notification: NewNotification("Webhook", &WebhookNotification{WebhookUrl: "https://example.com", WebhookContentType: "xml"}),
+			want:         utils.NewString(`unknown webhookContentType "xml"`),
+		},
+		{
+			name:         "matrix missing fields",
+			notification: NewNotification("Matrix", &MatrixNotification{HomeserverUrl: "https://matrix.org"}),
+			want:         utils.NewString("matrix notification requires accessToken, internalRoomId"),
+		},
+		{
+			name:         "smtp without recipient",
+			notification: NewNotification("Mail", &SmtpNotification{SmtpHost: "smtp.example.com", SmtpPort: 587, SmtpFrom: "kuma@example.com"}),
+			want:         utils.NewString("requires one of smtpTo, smtpCC, smtpBCC"),
+		},
+		{
+			name:         "smtp invalid port",
+			notification: NewNotification("Mail", &SmtpNotification{SmtpHost: "smtp.example.com", SmtpFrom: "kuma@example.com", SmtpTo: "ops@example.com"}),
+			want:         utils.NewString("smtpPort of smtp notification must be between 1 and 65535"),
+		},
+		{
+			name:         "ntfy priority out of range",
+			notification: NewNotification("ntfy", &NtfyNotification{NtfyServerUrl: "https://ntfy.sh", NtfyTopic: "kuma", NtfyPriority: utils.NewInt(6)}),
+			want:         utils.NewString("ntfyPriority of ntfy notification must be between 1 and 5"),
+		},
+		{
+			name:         "opsgenie invalid region",
+			notification: NewNotification("Opsgenie", &OpsgenieNotification{OpsgenieApiKey: "key", OpsgenieRegion: "asia"}),
+			want:         utils.NewString(`unknown opsgenieRegion "asia"`),
+		},
+		{
+			name:         "nil provider",
+			notification: NewNotification("Webhook", nil),
+			want:         utils.NewString("notification type is required"),
+		},
+		{
+			name:         "type mismatch",
+			notification: &Notification{Name: "Discord", Type: "slack", Provider: &DiscordNotification{DiscordWebhookUrl: "https://example.com"}},
+			want:         utils.NewString(`notification type "slack" does not match provider type "discord"`),
+		},
+		{
+			name:         "missing type",
+			notification: &Notification{Name: "Unknown"},
+			want:         utils.NewString("notification type is required"),
+		},
+		{
+			name:         "untyped",
+			notification: &Notification{Name: "Apprise", Type: "apprise"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.notification.Validate()
+
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, ErrValidation)
+			assert.ErrorContains(t, err, *tt.want)
+		})
+	}
+}
+
+func TestNotificationFromState(t *testing.T) {
+	n := &state.Notification{
+		Id:        1,
+		Name:      "Gotify",
+		IsDefault: true,
+		Config:    `{"name":"Gotify","type":"gotify","isDefault":true,"applyExisting":false,"gotifyserverurl":"https://gotify.example.com","gotifyapplicationToken":"token","gotifyPriority":"8","gotifyExtra":1}`,
+	}
+
+	got, err := NotificationFromState(n)
+	assert.NoError(t, err)
+
+	assert.Equal(t, &Notification{
+		Name:      "Gotify",
+		Type:      "gotify",
+		IsDefault: true,
+		Provider: &GotifyNotification{
+			GotifyServerUrl:        "https://gotify.example.com",
+			GotifyApplicationToken: "token",
+			GotifyPriority:         utils.NewInt(8),
+			Unmapped:               map[string]any{"gotifyExtra": float64(1)},
+		},
+	}, got)
+
+	// the unmapped fields are sent back when editing the notification
+	raw, err := json.Marshal(got)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Gotify","type":"gotify","isDefault":true,"applyExisting":false,"gotifyserverurl":"https://gotify.example.com","gotifyapplicationToken":"token","gotifyPriority":8,"gotifyExtra":1}`, string(raw))
+}
@@ -0,0 +1,146 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+)
+
+const (
+	addProxyAction    = "addProxy"
+	deleteProxyAction = "deleteProxy"
+)
+
+// Proxy is the configuration of a proxy as sent with the addProxy action.
+type Proxy struct {
+	// Protocol is one of "https", "http", "socks", "socks5", "socks5h" or "socks4".
+	Protocol string `json:"protocol"`
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+
+	// Auth enables the authentication with Username and Password.
+	Auth     bool   `json:"auth"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+
+	// Default applies the proxy to new monitors.
+	Default bool `json:"default"`
+
+	// ApplyExisting applies the proxy to all existing monitors when saving it.
+	ApplyExisting bool `json:"applyExisting"`
+}
+
+// Validate returns an error wrapping ErrValidation if a required field is missing or invalid.
+func (p *Proxy) Validate() error {
+	switch p.Protocol {
+	case "https", "http", "socks", "socks5", "socks5h", "socks4":
+	default:
+		return fmt.Errorf("%w: unknown proxy protocol %q", ErrValidation, p.Protocol)
+	}
+
+	if p.Host == "" {
+		return fmt.Errorf("%w: proxy host is required", ErrValidation)
+	}
+
+	if p.Port < 1 || p.Port > 65535 {
+		return fmt.Errorf("%w: proxy port must be between 1 and 65535", ErrValidation)
+	}
+
+	if p.Auth && p.Username == "" {
+		return fmt.Errorf("%w: proxy username is required for authentication", ErrValidation)
+	}
+
+	return nil
+}
+
+type addProxyResponse struct {
+	Ok      bool    `mapstructure:"ok"`
+	Msg     *string `mapstructure:"msg"`
+	ProxyId *int    `mapstructure:"id"`
+}
+
+type deleteProxyResponse struct {
+	Ok  bool    `mapstructure:"ok"`
+	Msg *string `mapstructure:"msg"`
+}
+
+// AddProxy adds a new proxy to the Uptime Kuma instance and returns its id.
+func AddProxy(ctx context.Context, c StatefulEmiter, proxy *Proxy) (int, error) {
+	return saveProxy(ctx, c, nil, proxy)
+}
+
+// EditProxy replaces the configuration of the proxy with the given id.
+func EditProxy(ctx context.Context, c StatefulEmiter, proxyId int, proxy *Proxy) error {
+	_, err := saveProxy(ctx, c, &proxyId, proxy)
+	return err
+}
+
+// saveProxy creates a new proxy if proxyId is nil, otherwise it edits the existing proxy.
+func saveProxy(ctx context.Context, c StatefulEmiter, proxyId *int, proxy *Proxy) (int, error) {
+	if proxy == nil {
+		return 0, wrapErrActionFailed(addProxyAction, fmt.Errorf("%w: proxy is nil", ErrValidation))
+	}
+
+	// validate proxy before sending it
+	if err := proxy.Validate(); err != nil {
+		return 0, wrapErrActionFailed(addProxyAction, err)
+	}
+
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return 0, wrapErrActionFailed(addProxyAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action, a nil id creates a new proxy
+	response, err := c.Emit(ctx, addProxyAction, proxy, proxyId)
+	if err != nil {
+		return 0, wrapErrActionFailed(addProxyAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &addProxyResponse{}
+	if err := decode(response, data); err != nil {
+		return 0, wrapErrActionFailed(addProxyAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return 0, newErrResponse(addProxyAction, response)
+	}
+
+	// check if proxy id is present
+	if data.ProxyId == nil {
+		return 0, wrapErrActionFailed(addProxyAction, ErrInvalidResponse)
+	}
+
+	return *data.ProxyId, nil
+}
+
+// DeleteProxy deletes the proxy with the given id. Monitors using the proxy are updated to not use
+// a proxy anymore.
+func DeleteProxy(ctx context.Context, c StatefulEmiter, proxyId int) error {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(deleteProxyAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, deleteProxyAction, proxyId)
+	if err != nil {
+		return wrapErrActionFailed(deleteProxyAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &deleteProxyResponse{}
+	if err := decode(response, data); err != nil {
+		return wrapErrActionFailed(deleteProxyAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return newErrResponse(deleteProxyAction, response)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package action
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProxy_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		proxy *Proxy
+		want  *string
+	}{
+		{
+			name:  "http",
+			proxy: &Proxy{Protocol: "http", Host: "proxy.example.com", Port: 3128},
+		},
+		{
+			name:  "socks5 with auth",
+			proxy: &Proxy{Protocol: "socks5", Host: "proxy.example.com", Port: 1080, Auth: true, Username: "user", Password: "secret"},
+		},
+		{
+			name:  "unknown protocol",
+			proxy: &Proxy{Protocol: "ftp", Host: "proxy.example.com", Port: 21},
+			want:  utils.NewString(`unknown proxy protocol "ftp"`),
+		},
+		{
+			name:  "missing host",
+			proxy: &Proxy{Protocol: "http", Port: 3128},
+			want:  utils.NewString("proxy host is required"),
+		},
+		{
+			name:  "port out of range",
+			proxy: &Proxy{Protocol: "http", Host: "proxy.example.com", Port: 65536},
+			want:  utils.NewString("proxy port must be between 1 and 65535"),
+		},
+		{
+			name:  "auth without username",
+			proxy: &Proxy{Protocol: "http", Host: "proxy.example.com", Port: 3128, Auth: true},
+			want:  utils.NewString("proxy username is required for authentication"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proxy.Validate()
+
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, ErrValidation)
+			assert.ErrorContains(t, err, *tt.want)
+		})
+	}
+}
+
+func TestSaveProxy(t *testing.T) {
+	proxy := &Proxy{Protocol: "http", Host: "proxy.example.com", Port: 3128}
+
+	// adding sends a nil id
+	c := mocks.NewStatefulEmiter(t)
+	c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(nil).Once()
+	c.EXPECT().Emit(mock.Anything, addProxyAction, proxy, (*int)(nil)).
+		Return([]any{[]byte(`{"ok":true,"msg":"Saved","id":4}`)}, nil).Once()
+
+	id, err := AddProxy(context.Background(), c, proxy)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, id)
+
+	// editing sends the id of the proxy
+	c = mocks.NewStatefulEmiter(t)
+	c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(nil).Once()
+	c.EXPECT().Emit(mock.Anything, addProxyAction, proxy, utils.NewInt(4)).
+		Return([]any{[]byte(`{"ok":true,"msg":"Saved","id":4}`)}, nil).Once()
+
+	assert.NoError(t, EditProxy(context.Background(), c, 4, proxy))
+
+	// invalid proxies are not sent
+	c = mocks.NewStatefulEmiter(t)
+
+	_, err = AddProxy(context.Background(), c, &Proxy{Protocol: "http", Port: 3128})
+	assert.ErrorIs(t, err, ErrValidation)
+
+	assert.ErrorIs(t, EditProxy(context.Background(), c, 4, nil), ErrValidation)
+}
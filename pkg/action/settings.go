@@ -1,7 +1,11 @@
 package action
 
 import (
+	"context"
+	"encoding/json"
+
 	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
 )
 
 const (
@@ -24,7 +28,20 @@ type Settings struct {
 	DisableAuth         *bool   `mapstructure:"disableAuth" json:"disableAuth,omitempty"`
 	TrustProxy          *bool   `mapstructure:"trustProxy" json:"trustProxy,omitempty"`
 
-	Unmapped map[string]any `mapstructure:",remain"`
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+// MarshalJSON encodes the settings including the unmapped ones, so settings returned by GetSettings
+// can be sent back with SetSettings without dropping settings unknown to this package.
+func (s Settings) MarshalJSON() ([]byte, error) {
+	type settings Settings
+
+	data, err := utils.EncodeMap(settings(s))
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(data)
 }
 
 type getSettingsResponse struct {
@@ -39,54 +56,54 @@ type setSettingsResponse struct {
 }
 
 // GetSettings returns the settings of the Uptime Kuma instance.
-func GetSettings(c StatefulEmiter) (*Settings, error) {
+func GetSettings(ctx context.Context, c StatefulEmiter) (*Settings, error) {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return nil, NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return nil, wrapErrActionFailed(getSettingsAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	reseponse, err := c.Emit(getSettingsAction, defaultEmitTimeout)
+	response, err := c.Emit(ctx, getSettingsAction)
 	if err != nil {
-		return nil, NewErrActionFailed(getSettingsAction, err.Error())
+		return nil, wrapErrActionFailed(getSettingsAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &getSettingsResponse{}
-	if err := decode(reseponse, data); err != nil {
-		return nil, NewErrActionFailed(getSettingsAction, err.Error())
+	if err := decode(response, data); err != nil {
+		return nil, wrapErrActionFailed(getSettingsAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return nil, NewErrActionFailed(getSettingsAction, *data.Msg)
+		return nil, newErrResponse(getSettingsAction, response)
 	}
 
 	return data.Data, nil
 }
 
 // SetSettings sets the settings of the Uptime Kuma instance.
-func SetSettings(c StatefulEmiter, settings *Settings, password string) error {
+func SetSettings(ctx context.Context, c StatefulEmiter, settings *Settings, password string) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(setSettingsAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	reseponse, err := c.Emit(setSettingsAction, defaultEmitTimeout, settings, password)
+	response, err := c.Emit(ctx, setSettingsAction, settings, password)
 	if err != nil {
-		return NewErrActionFailed(setSettingsAction, err.Error())
+		return wrapErrActionFailed(setSettingsAction, err)
 	}
 
 	// unmarshal raw response data
 	data := &setSettingsResponse{}
-	if err := decode(reseponse, data); err != nil {
-		return NewErrActionFailed(setSettingsAction, err.Error())
+	if err := decode(response, data); err != nil {
+		return wrapErrActionFailed(setSettingsAction, err)
 	}
 
 	// check if action was successful
 	if !data.Ok {
-		return NewErrActionFailed(setSettingsAction, *data.Msg)
+		return newErrResponse(setSettingsAction, response)
 	}
 
 	return nil
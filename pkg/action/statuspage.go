@@ -0,0 +1,252 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+)
+
+const (
+	addStatusPageAction    = "addStatusPage"
+	getStatusPageAction    = "getStatusPage"
+	saveStatusPageAction   = "saveStatusPage"
+	deleteStatusPageAction = "deleteStatusPage"
+
+	postIncidentAction  = "postIncident"
+	unpinIncidentAction = "unpinIncident"
+)
+
+// defaultStatusPageIcon is the logo of a status page without a custom logo.
+const defaultStatusPageIcon = "/icon.svg"
+
+type addStatusPageResponse struct {
+	Ok  bool    `mapstructure:"ok"`
+	Msg *string `mapstructure:"msg"`
+}
+
+type getStatusPageResponse struct {
+	Ok     bool              `mapstructure:"ok"`
+	Msg    *string           `mapstructure:"msg"`
+	Config *state.StatusPage `mapstructure:"config"`
+}
+
+type saveStatusPageResponse struct {
+	Ok              bool                `mapstructure:"ok"`
+	Msg             *string             `mapstructure:"msg"`
+	PublicGroupList []state.PublicGroup `mapstructure:"publicGroupList"`
+}
+
+type deleteStatusPageResponse struct {
+	Ok  bool    `mapstructure:"ok"`
+	Msg *string `mapstructure:"msg"`
+}
+
+type postIncidentResponse struct {
+	Ok       bool            `mapstructure:"ok"`
+	Msg      *string         `mapstructure:"msg"`
+	Incident *state.Incident `mapstructure:"incident"`
+}
+
+type unpinIncidentResponse struct {
+	Ok  bool    `mapstructure:"ok"`
+	Msg *string `mapstructure:"msg"`
+}
+
+// AddStatusPage adds a new, empty status page with the given title and slug. Use SaveStatusPage to
+// configure it.
+func AddStatusPage(ctx context.Context, c StatefulEmiter, title, slug string) error {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(addStatusPageAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, addStatusPageAction, title, slug)
+	if err != nil {
+		return wrapErrActionFailed(addStatusPageAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &addStatusPageResponse{}
+	if err := decode(response, data); err != nil {
+		return wrapErrActionFailed(addStatusPageAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return newErrResponse(addStatusPageAction, response)
+	}
+
+	return nil
+}
+
+// GetStatusPage requests the configuration of the status page with the given slug. The status page
+// is also stored or updated in the client state.
+func GetStatusPage(ctx context.Context, c StatefulEmiter, slug string) (*state.StatusPage, error) {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return nil, wrapErrActionFailed(getStatusPageAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, getStatusPageAction, slug)
+	if err != nil {
+		return nil, wrapErrActionFailed(getStatusPageAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &getStatusPageResponse{}
+	if err := decode(response, data); err != nil {
+		return nil, wrapErrActionFailed(getStatusPageAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return nil, newErrResponse(getStatusPageAction, response)
+	}
+
+	// check if status page is present
+	if data.Config == nil {
+		return nil, wrapErrActionFailed(getStatusPageAction, ErrInvalidResponse)
+	}
+
+	// update state
+	if err := c.State().SetStatusPage(data.Config.Id, data.Config); err != nil {
+		return nil, wrapErrActionFailed(getStatusPageAction, err)
+	}
+
+	return data.Config, nil
+}
+
+// SaveStatusPage saves the configuration and the public groups of the status page with the given
+// slug. The slug of the status page is changed if statusPage.Slug differs from slug. The public
+// groups replace the existing groups, they are returned with the ids assigned by the server.
+func SaveStatusPage(ctx context.Context, c StatefulEmiter, slug string, statusPage *state.StatusPage, publicGroups []state.PublicGroup) ([]state.PublicGroup, error) {
+	if statusPage == nil {
+		return nil, wrapErrActionFailed(saveStatusPageAction, fmt.Errorf("%w: status page is nil", ErrValidation))
+	}
+
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return nil, wrapErrActionFailed(saveStatusPageAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// the logo is sent separately, either as the path of the current logo or as data url
+	icon := statusPage.Icon
+	if icon == "" {
+		icon = defaultStatusPageIcon
+	}
+
+	if publicGroups == nil {
+		publicGroups = []state.PublicGroup{}
+	}
+
+	// call action
+	response, err := c.Emit(ctx, saveStatusPageAction, slug, statusPage, icon, publicGroups)
+	if err != nil {
+		return nil, wrapErrActionFailed(saveStatusPageAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &saveStatusPageResponse{}
+	if err := decode(response, data); err != nil {
+		return nil, wrapErrActionFailed(saveStatusPageAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return nil, newErrResponse(saveStatusPageAction, response)
+	}
+
+	return data.PublicGroupList, nil
+}
+
+// DeleteStatusPage deletes the status page with the given slug.
+func DeleteStatusPage(ctx context.Context, c StatefulEmiter, slug string) error {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(deleteStatusPageAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, deleteStatusPageAction, slug)
+	if err != nil {
+		return wrapErrActionFailed(deleteStatusPageAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &deleteStatusPageResponse{}
+	if err := decode(response, data); err != nil {
+		return wrapErrActionFailed(deleteStatusPageAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return newErrResponse(deleteStatusPageAction, response)
+	}
+
+	return nil
+}
+
+// PostIncident posts an incident on the status page with the given slug, it is pinned to the top of
+// the page. If the incident has an id, the existing incident is updated instead. Returns the
+// incident as stored by the server.
+func PostIncident(ctx context.Context, c StatefulEmiter, slug string, incident *state.Incident) (*state.Incident, error) {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return nil, wrapErrActionFailed(postIncidentAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, postIncidentAction, slug, incident)
+	if err != nil {
+		return nil, wrapErrActionFailed(postIncidentAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &postIncidentResponse{}
+	if err := decode(response, data); err != nil {
+		return nil, wrapErrActionFailed(postIncidentAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return nil, newErrResponse(postIncidentAction, response)
+	}
+
+	// check if incident is present
+	if data.Incident == nil {
+		return nil, wrapErrActionFailed(postIncidentAction, ErrInvalidResponse)
+	}
+
+	return data.Incident, nil
+}
+
+// UnpinIncident unpins the incident of the status page with the given slug, it is no longer shown.
+func UnpinIncident(ctx context.Context, c StatefulEmiter, slug string) error {
+	// ensure client is connected
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(unpinIncidentAction, NewErrAwaitFailed(handler.ConnectEvent, err))
+	}
+
+	// call action
+	response, err := c.Emit(ctx, unpinIncidentAction, slug)
+	if err != nil {
+		return wrapErrActionFailed(unpinIncidentAction, err)
+	}
+
+	// unmarshal raw response data
+	data := &unpinIncidentResponse{}
+	if err := decode(response, data); err != nil {
+		return wrapErrActionFailed(unpinIncidentAction, err)
+	}
+
+	// check if action was successful
+	if !data.Ok {
+		return newErrResponse(unpinIncidentAction, response)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package action
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSaveStatusPage(t *testing.T) {
+	groups := []state.PublicGroup{{Name: "Services", MonitorList: []state.PublicMonitor{{Id: 1}}}}
+
+	tests := []struct {
+		name       string
+		statusPage *state.StatusPage
+		groups     []state.PublicGroup
+		wantIcon   string
+		wantGroups []state.PublicGroup
+	}{
+		{
+			name:       "custom icon",
+			statusPage: &state.StatusPage{Slug: "status", Title: "Status", Icon: "/upload/logo1.png"},
+			groups:     groups,
+			wantIcon:   "/upload/logo1.png",
+			wantGroups: groups,
+		},
+		{
+			name:       "default icon",
+			statusPage: &state.StatusPage{Slug: "status", Title: "Status"},
+			groups:     groups,
+			wantIcon:   defaultStatusPageIcon,
+			wantGroups: groups,
+		},
+		{
+			name:       "no groups",
+			statusPage: &state.StatusPage{Slug: "status", Title: "Status"},
+			wantIcon:   defaultStatusPageIcon,
+			wantGroups: []state.PublicGroup{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := mocks.NewStatefulEmiter(t)
+			c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(nil).Once()
+
+			// the server expects the slug, the config, the logo and the public groups in this order
+			c.EXPECT().Emit(mock.Anything, saveStatusPageAction, "status", tt.statusPage, tt.wantIcon, tt.wantGroups).
+				Return([]any{[]byte(`{"ok":true,"publicGroupList":[{"id":7,"name":"Services","weight":1,"monitorList":[{"id":1}]}]}`)}, nil).Once()
+
+			got, err := SaveStatusPage(context.Background(), c, "status", tt.statusPage, tt.groups)
+			assert.NoError(t, err)
+			assert.Equal(t, []state.PublicGroup{{Id: 7, Name: "Services", Weight: 1, MonitorList: []state.PublicMonitor{{Id: 1}}}}, got)
+		})
+	}
+}
+
+func TestSaveStatusPage_Failed(t *testing.T) {
+	c := mocks.NewStatefulEmiter(t)
+
+	// nil status pages are not sent
+	_, err := SaveStatusPage(context.Background(), c, "status", nil, nil)
+	assert.ErrorIs(t, err, ErrValidation)
+
+	c.EXPECT().Await(mock.Anything, handler.ConnectEvent).Return(nil).Once()
+	c.EXPECT().Emit(mock.Anything, saveStatusPageAction, "missing", mock.Anything, mock.Anything, mock.Anything).
+		Return([]any{[]byte(`{"ok":false,"msg":"No slug?"}`)}, nil).Once()
+
+	_, err = SaveStatusPage(context.Background(), c, "missing", &state.StatusPage{}, nil)
+
+	var actionErr ErrActionFailed
+	if assert.ErrorAs(t, err, &actionErr) {
+		assert.Equal(t, saveStatusPageAction, actionErr.Action)
+		assert.Equal(t, "No slug?", actionErr.Msg)
+	}
+}
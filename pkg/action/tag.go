@@ -1,6 +1,8 @@
 package action
 
 import (
+	"context"
+
 	"github.com/nobbs/uptime-kuma-api/pkg/handler"
 	"github.com/nobbs/uptime-kuma-api/pkg/state"
 )
@@ -47,136 +49,136 @@ type deleteTagResponse struct {
 	Msg *string `mapstructure:"msg"`
 }
 
-func GetTags(c StatefulEmiter) ([]state.Tag, error) {
+func GetTags(ctx context.Context, c StatefulEmiter) ([]state.Tag, error) {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return nil, NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return nil, wrapErrActionFailed(getTagsAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
-	response, err := c.Emit(getTagsAction, defaultEmitTimeout)
+	response, err := c.Emit(ctx, getTagsAction)
 	if err != nil {
-		return nil, NewErrActionFailed(getTagsAction, err.Error())
+		return nil, wrapErrActionFailed(getTagsAction, err)
 	}
 
 	// unmarshal data
 	data := &getTagsResponse{}
 	if err := decode(response, data); err != nil {
-		return nil, NewErrActionFailed(getTagsAction, err.Error())
+		return nil, wrapErrActionFailed(getTagsAction, err)
 	}
 
 	// check if the response is ok
 	if !data.Ok {
-		return nil, NewErrActionFailed(getTagsAction, *data.Msg)
+		return nil, newErrResponse(getTagsAction, response)
 	}
 
 	// set tags in state
 	err = c.State().SetTags(data.Tags)
 	if err != nil {
-		return nil, NewErrActionFailed(getTagsAction, err.Error())
+		return nil, wrapErrActionFailed(getTagsAction, err)
 	}
 
 	return data.Tags, nil
 }
 
-func AddTag(c StatefulEmiter, name string, color string) (*state.Tag, error) {
+func AddTag(ctx context.Context, c StatefulEmiter, name string, color string) (*state.Tag, error) {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return nil, NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return nil, wrapErrActionFailed(addTagAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(addTagAction, defaultEmitTimeout, &addTagRequest{
+	response, err := c.Emit(ctx, addTagAction, &addTagRequest{
 		New:   true,
 		Name:  name,
 		Color: color,
 	})
 	if err != nil {
-		return nil, NewErrActionFailed(addTagAction, err.Error())
+		return nil, wrapErrActionFailed(addTagAction, err)
 	}
 
 	// unmarshal data
 	data := &addTagResponse{}
 	if err := decode(response, data); err != nil {
-		return nil, NewErrActionFailed(addTagAction, err.Error())
+		return nil, wrapErrActionFailed(addTagAction, err)
 	}
 
 	// check if the response is ok
 	if !data.Ok {
-		return nil, NewErrActionFailed(addTagAction, *data.Msg)
+		return nil, newErrResponse(addTagAction, response)
 	}
 
 	// set tag in state
 	err = c.State().SetTag(data.Tag)
 	if err != nil {
-		return nil, NewErrActionFailed(addTagAction, err.Error())
+		return nil, wrapErrActionFailed(addTagAction, err)
 	}
 
 	return data.Tag, nil
 }
 
-func EditTag(c StatefulEmiter, id int, name, color string) (*state.Tag, error) {
+func EditTag(ctx context.Context, c StatefulEmiter, id int, name, color string) (*state.Tag, error) {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return nil, NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return nil, wrapErrActionFailed(editTagAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(editTagAction, defaultEmitTimeout, &editTagRequest{
+	response, err := c.Emit(ctx, editTagAction, &editTagRequest{
 		Id:    id,
 		Name:  name,
 		Color: color,
 	})
 	if err != nil {
-		return nil, NewErrActionFailed(editTagAction, err.Error())
+		return nil, wrapErrActionFailed(editTagAction, err)
 	}
 
 	// unmarshal data
 	data := &editTagResponse{}
 	if err := decode(response, data); err != nil {
-		return nil, NewErrActionFailed(editTagAction, err.Error())
+		return nil, wrapErrActionFailed(editTagAction, err)
 	}
 
 	// check if the response is ok
 	if !data.Ok {
-		return nil, NewErrActionFailed(editTagAction, *data.Msg)
+		return nil, newErrResponse(editTagAction, response)
 	}
 
 	// set tag in state
 	err = c.State().SetTag(data.Tag)
 	if err != nil {
-		return nil, NewErrActionFailed(editTagAction, err.Error())
+		return nil, wrapErrActionFailed(editTagAction, err)
 	}
 
 	return data.Tag, nil
 }
 
-func DeleteTag(c StatefulEmiter, id int) error {
+func DeleteTag(ctx context.Context, c StatefulEmiter, id int) error {
 	// ensure client is connected
-	if err := c.Await(handler.ConnectEvent, defaultAwaitTimeout); err != nil {
-		return NewErrAwaitFailed(handler.ConnectEvent, err)
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return wrapErrActionFailed(deleteTagAction, NewErrAwaitFailed(handler.ConnectEvent, err))
 	}
 
 	// call action
-	response, err := c.Emit(deleteTagAction, defaultEmitTimeout, id)
+	response, err := c.Emit(ctx, deleteTagAction, id)
 	if err != nil {
-		return NewErrActionFailed(deleteTagAction, err.Error())
+		return wrapErrActionFailed(deleteTagAction, err)
 	}
 
 	// unmarshal data
 	data := &deleteTagResponse{}
 	if err := decode(response, data); err != nil {
-		return NewErrActionFailed(deleteTagAction, err.Error())
+		return wrapErrActionFailed(deleteTagAction, err)
 	}
 
 	// check if the response is ok
 	if !data.Ok {
-		return NewErrActionFailed(deleteTagAction, *data.Msg)
+		return newErrResponse(deleteTagAction, response)
 	}
 
 	// delete tag from state
 	err = c.State().DeleteTag(id)
 	if err != nil {
-		return NewErrActionFailed(deleteTagAction, err.Error())
+		return wrapErrActionFailed(deleteTagAction, err)
 	}
 
 	return nil
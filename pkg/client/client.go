@@ -2,7 +2,9 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/Baiguoshuai1/shadiaosocketio"
@@ -12,12 +14,19 @@ import (
 )
 
 const (
-	defaultAwaitSleep = time.Duration(100) * time.Millisecond
+	// defaultEmitTimeout is the timeout for emitted events if the context has no deadline.
+	defaultEmitTimeout = time.Duration(5) * time.Second
+
+	// defaultAwaitTimeout is the timeout for awaited events if the context has no deadline.
+	defaultAwaitTimeout = time.Duration(5) * time.Second
 )
 
 // Client is the main client struct that is used to communicate with the server. It wraps the
 // socket.io client, keeps track of the state and provides methods to interact with the server.
 type Client struct {
+	// mu guards the connection, the known handlers and the reconnect bookkeeping below.
+	mu sync.RWMutex
+
 	// socketio is the underlying socket.io client connection.
 	socketio Connection
 
@@ -26,6 +35,39 @@ type Client struct {
 
 	// all known handlers
 	knownHandlers map[string]EventHandler
+
+	// events forwards the data of processed events to subscriptions.
+	events *broker
+
+	// invoker emits events through the interceptor chain.
+	invoker Invoker
+
+	// eventInterceptor is called for every received event, nil if there are no event interceptors.
+	eventInterceptor EventInterceptor
+
+	// dial creates a new connection to the server, nil if the client can't reconnect.
+	dial Dialer
+
+	// reconnectPolicy configures the reconnect backoff, nil disables reconnecting.
+	reconnectPolicy *ReconnectPolicy
+
+	// emitTimeout is the timeout for emitted events if the context has no deadline.
+	emitTimeout time.Duration
+
+	// awaitTimeout is the timeout for awaited events if the context has no deadline.
+	awaitTimeout time.Duration
+
+	// reconnecting is true while a reconnect is in progress.
+	reconnecting bool
+
+	// reconnected receives a signal after every successful reconnect.
+	reconnected chan struct{}
+
+	// closed is true after the client has been closed by the user.
+	closed bool
+
+	// done is closed when the client is closed, aborting any pending reconnect.
+	done chan struct{}
 }
 
 // Connection is the interface that wraps the basic socket.io connection methods. As socket.io is
@@ -35,6 +77,9 @@ type Connection interface {
 	// long to wait for the acknowledgement before returning an error.
 	Ack(string, time.Duration, ...any) (any, error)
 
+	// Emit sends an event to the server without waiting for an acknowledgement.
+	Emit(string, ...any) error
+
 	// On registers a handler for the given event.
 	On(string, any) error
 
@@ -55,46 +100,120 @@ type EventHandler interface {
 	Occurred() bool
 }
 
+// Dialer is a function that creates a new connection to the server.
+type Dialer func() (Connection, error)
+
 // NewClient creates a new client instance and connects to the server. Returns an error if the
-// connection fails.
-func NewClient(host string, port int, secure bool) (c *Client, err error) {
-	// create new socket.io client - this will connect to the server automatically
-	var socketio *shadiaosocketio.Client
+// connection fails. The client automatically reconnects using the default reconnect policy if the
+// connection is lost. The connection can be customized with options, e.g. WithTLSConfig.
+func NewClient(host string, port int, secure bool, opts ...Option) (c *Client, err error) {
+	o := newOptions(opts...)
+
+	dial := func() (Connection, error) {
+		transport := websocket.GetDefaultWebsocketTransport()
+		transport.TLSConfig = o.tlsConfig
+		transport.RequestHeader = o.requestHeader()
 
-	if socketio, err = shadiaosocketio.Dial(
-		shadiaosocketio.GetUrl(host, port, secure),
-		*websocket.GetDefaultWebsocketTransport(),
-	); err != nil {
-		return nil, fmt.Errorf("socket.io client creation failed: %w", err)
+		// create new socket.io client - this will connect to the server automatically
+		socketio, err := shadiaosocketio.Dial(o.url(host, port, secure), *transport)
+		if err != nil {
+			return nil, fmt.Errorf("socket.io client creation failed: %w", err)
+		}
+
+		return socketio, nil
 	}
 
-	// create new client instance with the socket.io connection
-	return NewClientWithConnection(socketio)
+	return NewClientWithDialer(dial, opts...)
 }
 
-func NewClientWithConnection(socketio Connection) (c *Client, err error) {
-	// create new state instance
-	s := state.NewState()
-
-	// initialize handlers
-	knownHandlers := map[string]EventHandler{
-		handler.AutoLoginEvent:              handler.NewAutoLogin(s),
-		handler.ConnectEvent:                handler.NewConnect(s),
-		handler.DisconnectEvent:             handler.NewDisconnect(s),
-		handler.ErrorEvent:                  handler.NewError(s),
-		handler.HeartbeatEvent:              handler.NewHeartbeat(s),
-		handler.HeartbeatListEvent:          handler.NewHeartbeatList(s),
-		handler.ImportantHeartbeatListEvent: handler.NewImportantHeartbeatList(s),
-		handler.InfoEvent:                   handler.NewInfo(s),
-		handler.MessageEvent:                handler.NewMessage(s),
-		handler.MonitorListEvent:            handler.NewMonitorList(s),
+// NewClientWithDialer creates a new client instance using the given dialer to connect to the server.
+// The dialer is called again to reconnect whenever the connection is lost. Options affecting the
+// connection itself are ignored, as the dialer is responsible for it.
+func NewClientWithDialer(dial Dialer, opts ...Option) (c *Client, err error) {
+	socketio, err := dial()
+	if err != nil {
+		return nil, err
 	}
 
+	// create new client instance with the socket.io connection, the dialer is set before any
+	// handler is registered, as a disconnect may trigger a reconnect right away
+	return newClient(socketio, dial, opts...)
+}
+
+// NewClientWithConnection creates a new client instance using an existing connection. As the client
+// does not know how to create a new connection, it does not reconnect if the connection is lost.
+// Options affecting the connection itself are ignored.
+func NewClientWithConnection(socketio Connection, opts ...Option) (c *Client, err error) {
+	return newClient(socketio, nil, opts...)
+}
+
+// newClient creates a new client instance using an existing connection and the dialer used to
+// reconnect, nil if the client can't reconnect.
+func newClient(socketio Connection, dial Dialer, opts ...Option) (c *Client, err error) {
+	o := newOptions(opts...)
+
 	// create new client instance
 	c = &Client{
-		socketio:      socketio,
-		state:         s,
-		knownHandlers: knownHandlers,
+		socketio:        socketio,
+		dial:            dial,
+		state:           state.NewState(),
+		reconnectPolicy: o.reconnectPolicy,
+		emitTimeout:     o.emitTimeout,
+		awaitTimeout:    o.awaitTimeout,
+		events:          newBroker(),
+		reconnected:     make(chan struct{}, 1),
+		done:            make(chan struct{}),
+	}
+
+	interceptors := o.interceptors
+	if o.retryPolicy != nil {
+		interceptors = append(interceptors[:len(interceptors):len(interceptors)], RetryInterceptor(o.retryPolicy))
+	}
+
+	c.invoker = chainInterceptors(interceptors, c.emit)
+	if len(o.eventInterceptors) > 0 {
+		c.eventInterceptor = chainEventInterceptors(o.eventInterceptors)
+	}
+
+	s, p := c.state, c.events
+
+	// initialize handlers, the known events without a dedicated handler are forwarded as raw events
+	c.knownHandlers = make(map[string]EventHandler, len(handler.RawEvents)+len(handler.CloudflaredEvents)+len(o.handlers))
+	for _, event := range handler.RawEvents {
+		c.knownHandlers[event] = handler.NewRaw(event, s, p)
+	}
+
+	for _, event := range handler.CloudflaredEvents {
+		c.knownHandlers[event] = handler.NewCloudflared(event, s, p)
+	}
+
+	for event, h := range map[string]EventHandler{
+		handler.APIKeyListEvent:             handler.NewAPIKeyList(s, p),
+		handler.AutoLoginEvent:              handler.NewAutoLogin(s, p),
+		handler.AvgPingEvent:                handler.NewAvgPing(s, p),
+		handler.CertInfoEvent:               handler.NewCertInfo(s, p),
+		handler.ConnectEvent:                handler.NewConnect(s, p),
+		handler.DisconnectEvent:             handler.NewDisconnect(s, c, p),
+		handler.DockerHostListEvent:         handler.NewDockerHostList(s, p),
+		handler.ErrorEvent:                  handler.NewError(s, p),
+		handler.HeartbeatEvent:              handler.NewHeartbeat(s, p),
+		handler.HeartbeatListEvent:          handler.NewHeartbeatList(s, p),
+		handler.ImportantHeartbeatListEvent: handler.NewImportantHeartbeatList(s, p),
+		handler.InfoEvent:                   handler.NewInfo(s, p),
+		handler.MaintenanceListEvent:        handler.NewMaintenanceList(s, p),
+		handler.MessageEvent:                handler.NewMessage(s, p),
+		handler.MonitorListEvent:            handler.NewMonitorList(s, p),
+		handler.NotificationListEvent:       handler.NewNotificationList(s, p),
+		handler.ProxyListEvent:              handler.NewProxyList(s, p),
+		handler.StatusPageListEvent:         handler.NewStatusPageList(s, p),
+		handler.UptimeEvent:                 handler.NewUptime(s, p),
+	} {
+		c.knownHandlers[event] = h
+	}
+
+	// custom handlers may override the built-in ones
+	for _, h := range o.handlers {
+		c.knownHandlers[h.Event()] = h
 	}
 
 	// register handlers
@@ -110,55 +229,201 @@ func (c *Client) State() *state.State {
 	return c.state
 }
 
-// Emit sends an event to the server and waits for an acknowledgement. The timeout specifies how
-// long to wait for the acknowledgement before returning an error.
-func (c *Client) Emit(event string, timeout time.Duration, args ...any) (any, error) {
-	return c.socketio.Ack(event, timeout, args...)
+// Emit sends an event to the server and waits for an acknowledgement, passing it through all
+// interceptors of the client. If the context has no deadline, the emit timeout of the client is
+// applied. Returns ErrTimeout, wrapping context.DeadlineExceeded, if no acknowledgement is received
+// in time and the context error if the context is cancelled.
+func (c *Client) Emit(ctx context.Context, event string, args ...any) (any, error) {
+	return c.invoker(ctx, event, args...)
+}
+
+// Send sends an event to the server without waiting for an acknowledgement, for the events the
+// server does not acknowledge. The interceptors of the client are not applied. Returns the context
+// error if the context is already done.
+func (c *Client) Send(ctx context.Context, event string, args ...any) error {
+	if ctx.Err() != nil {
+		return contextError(ctx)
+	}
+
+	return c.connection().Emit(event, args...)
+}
+
+// emit sends an event to the server and waits for an acknowledgement, see Emit.
+func (c *Client) emit(ctx context.Context, event string, args ...any) (any, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, c.emitTimeout)
+		defer cancel()
+	}
+
+	if ctx.Err() != nil {
+		return nil, contextError(ctx)
+	}
+
+	type result struct {
+		response any
+		err      error
+	}
+
+	// The socket.io acknowledgement can't be cancelled, so it is bounded by the deadline of the
+	// context and abandoned if the context is done earlier.
+	deadline, _ := ctx.Deadline()
+	done := make(chan result, 1)
+
+	go func() {
+		response, err := c.connection().Ack(event, time.Until(deadline), args...)
+		done <- result{response: response, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		// report the acknowledgement timeout like an exceeded deadline of the context
+		if errors.Is(r.err, shadiaosocketio.ErrorSendTimeout) {
+			return nil, fmt.Errorf("%w: %w", ErrTimeout, context.DeadlineExceeded)
+		}
+
+		return r.response, r.err
+	case <-ctx.Done():
+		return nil, contextError(ctx)
+	}
 }
 
-// On registers a handler for the given event.
+// On registers a handler for the given event. The handler is called through the event interceptors
+// of the client, if any.
 func (c *Client) On(event string, handler any) error {
-	return c.socketio.On(event, handler)
+	if c.eventInterceptor != nil {
+		handler = interceptEvent(c.eventInterceptor, event, handler)
+	}
+
+	return c.connection().On(event, handler)
 }
 
-// Close closes the client connection.
+// RegisterHandler registers an additional handler with the client, replacing any handler for the
+// same event, including the built-in ones. The handler is registered with the current connection
+// right away and again after every reconnect. See also WithHandlers.
+func (c *Client) RegisterHandler(h EventHandler) error {
+	c.mu.Lock()
+	c.knownHandlers[h.Event()] = h
+	c.mu.Unlock()
+
+	if err := h.Register(c); err != nil {
+		return fmt.Errorf("registering %s handler: %w", h.Event(), err)
+	}
+
+	return nil
+}
+
+// Publish forwards the data of a processed event to all subscriptions and waiters of the event.
+// Custom handlers should call it after processing an event, so that Await and AwaitNext work for
+// them, e.g. by passing the client as handler.Publisher.
+func (c *Client) Publish(event string, data any) {
+	c.events.Publish(event, data)
+}
+
+// Close closes the client connection and all subscriptions. A closed client does not reconnect.
 func (c *Client) Close() {
-	c.socketio.Close()
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return
+	}
+
+	c.closed = true
+	close(c.done)
+	socketio := c.socketio
+	c.mu.Unlock()
+
+	socketio.Close()
+	c.events.close()
 }
 
-// Await waits for the given event to occur for the first time. The timeout specifies how long to
-// wait for the event before returning an error.
-func (c *Client) Await(event string, timeout time.Duration) error {
-	// Create a context with the specified timeout.
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
+// connection returns the current connection to the server.
+func (c *Client) connection() Connection {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 
-	// Create a channel to receive a signal that work is done.
-	eventChannel := make(chan bool, 1)
+	return c.socketio
+}
 
-	// Start a goroutine to perform the check asynchronously.
-	go func() {
-		for {
-			if ok := c.knownHandlers[event].Occurred(); ok {
-				eventChannel <- ok
-				return
-			}
+// Await waits until the given event has occurred, returning immediately if it already has. If the
+// context has no deadline, the await timeout of the client is applied. Returns ErrTimeout if the
+// event does not occur in time, ErrClosed if the client is closed and the context error if the
+// context is cancelled.
+func (c *Client) Await(ctx context.Context, event string) error {
+	h, ok := c.handler(event)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownEvent, event)
+	}
+
+	return c.await(ctx, event, h.Occurred)
+}
 
-			time.Sleep(defaultAwaitSleep)
+// AwaitNext waits for the next occurrence of the given event, ignoring any earlier occurrences. If
+// the context has no deadline, the await timeout of the client is applied. Returns the same errors
+// as Await.
+func (c *Client) AwaitNext(ctx context.Context, event string) error {
+	if _, ok := c.handler(event); !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownEvent, event)
+	}
+
+	return c.await(ctx, event, nil)
+}
+
+// await blocks the calling goroutine until the handler of the event notifies about an occurrence
+// and occurred, if set, returns true. No goroutine is started, so nothing outlives the call.
+func (c *Client) await(ctx context.Context, event string, occurred func() bool) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, c.awaitTimeout)
+		defer cancel()
+	}
+
+	// listen before checking, so an occurrence in between is not missed
+	notified, stop := c.events.notify(event)
+	defer stop()
+
+	for {
+		if occurred != nil && occurred() {
+			return nil
 		}
-	}()
 
-	select {
-	case <-eventChannel:
-		return nil
-	case <-ctx.Done():
-		return ErrTimeout
+		select {
+		case _, ok := <-notified:
+			if !ok {
+				return ErrClosed
+			}
+
+			if occurred == nil {
+				return nil
+			}
+		case <-ctx.Done():
+			return contextError(ctx)
+		}
 	}
 }
 
+// handler returns the known handler for the given event.
+func (c *Client) handler(event string) (EventHandler, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	h, ok := c.knownHandlers[event]
+
+	return h, ok
+}
+
 // registerHandlers registers all known handlers with the client.
 func (c *Client) registerHandlers() error {
+	c.mu.RLock()
+	handlers := make([]EventHandler, 0, len(c.knownHandlers))
 	for _, h := range c.knownHandlers {
+		handlers = append(handlers, h)
+	}
+	c.mu.RUnlock()
+
+	for _, h := range handlers {
 		if err := h.Register(c); err != nil {
 			return fmt.Errorf("registering %s handler: %w", h.Event(), err)
 		}
@@ -0,0 +1,441 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/client"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// fakeConnection is a connection mock that records the callbacks registered by the client, so that
+// tests can trigger events.
+type fakeConnection struct {
+	*mocks.Connection
+
+	mu        sync.Mutex
+	callbacks map[string]any
+}
+
+// newFakeConnection returns a new fakeConnection, accepting any number of registered callbacks.
+func newFakeConnection(t *testing.T) *fakeConnection {
+	conn := &fakeConnection{Connection: mocks.NewConnection(t), callbacks: map[string]any{}}
+
+	conn.EXPECT().On(mock.Anything, mock.Anything).RunAndReturn(func(event string, callback any) error {
+		conn.mu.Lock()
+		defer conn.mu.Unlock()
+
+		conn.callbacks[event] = callback
+
+		return nil
+	}).Maybe()
+
+	return conn
+}
+
+// registered returns true if a callback has been registered for the given event.
+func (f *fakeConnection) registered(event string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, ok := f.callbacks[event]
+
+	return ok
+}
+
+// trigger calls the callback registered for the given event with a channel and the given arguments,
+// like the socket.io client does when receiving the event.
+func (f *fakeConnection) trigger(t *testing.T, event string, args ...any) error {
+	t.Helper()
+
+	f.mu.Lock()
+	callback, ok := f.callbacks[event]
+	f.mu.Unlock()
+
+	if !assert.True(t, ok, "no callback registered for %s", event) {
+		return nil
+	}
+
+	fn := reflect.ValueOf(callback)
+
+	in := []reflect.Value{reflect.ValueOf(&shadiaosocketio.Channel{})}
+	for i, arg := range args {
+		if arg == nil {
+			in = append(in, reflect.Zero(fn.Type().In(i+1)))
+			continue
+		}
+
+		in = append(in, reflect.ValueOf(arg))
+	}
+
+	out := fn.Call(in)
+	if len(out) == 0 || out[len(out)-1].IsNil() {
+		return nil
+	}
+
+	return out[len(out)-1].Interface().(error) //nolint:forcetypeassert // callbacks return errors
+}
+
+// dialerMock is a mock of client.Dialer. It is not generated into the mocks package, as the mocks
+// are also used by the tests of packages the client depends on.
+type dialerMock struct {
+	mock.Mock
+}
+
+// newDialerMock returns a new dialerMock that asserts its expectations when the test finishes.
+func newDialerMock(t *testing.T) *dialerMock {
+	d := &dialerMock{}
+	d.Test(t)
+
+	t.Cleanup(func() { d.AssertExpectations(t) })
+
+	return d
+}
+
+// Dial returns the connection and error of the matching expectation.
+func (d *dialerMock) Dial() (client.Connection, error) {
+	args := d.Called()
+	conn, _ := args.Get(0).(client.Connection)
+
+	return conn, args.Error(1)
+}
+
+// waitFor fails the test if the channel does not receive a value in time.
+func waitFor[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for channel")
+	}
+
+	var zero T
+
+	return zero
+}
+
+func TestClient_Emit(t *testing.T) {
+	// blocked acknowledgements are released when the test finishes
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+
+	block := func(string, time.Duration, ...any) (any, error) {
+		<-release
+		return nil, nil
+	}
+
+	// sendTimeout times out like the socket.io client, after the given timeout
+	sendTimeout := func(_ string, timeout time.Duration, _ ...any) (any, error) {
+		time.Sleep(timeout)
+		return nil, shadiaosocketio.ErrorSendTimeout
+	}
+
+	tests := []struct {
+		name        string
+		ctxTimeout  time.Duration
+		cancel      bool
+		cancelAfter time.Duration
+		ack         func(string, time.Duration, ...any) (any, error)
+		want        any
+		wantErr     []error
+		wantTimeout time.Duration
+	}{
+		{
+			name:        "acknowledged",
+			ack:         func(string, time.Duration, ...any) (any, error) { return []any{[]byte(`{"ok":true}`)}, nil },
+			want:        []any{[]byte(`{"ok":true}`)},
+			wantTimeout: 50 * time.Millisecond,
+		},
+		{
+			name:    "cancelled before emitting",
+			cancel:  true,
+			wantErr: []error{context.Canceled},
+		},
+		{
+			name:        "cancelled before acknowledgement",
+			cancelAfter: 10 * time.Millisecond,
+			ack:         block,
+			wantErr:     []error{context.Canceled},
+			wantTimeout: 50 * time.Millisecond,
+		},
+		{
+			name:        "context deadline shorter than emit timeout",
+			ctxTimeout:  10 * time.Millisecond,
+			ack:         block,
+			wantErr:     []error{client.ErrTimeout, context.DeadlineExceeded},
+			wantTimeout: 10 * time.Millisecond,
+		},
+		{
+			name:        "default emit timeout",
+			ack:         sendTimeout,
+			wantErr:     []error{client.ErrTimeout, context.DeadlineExceeded},
+			wantTimeout: 50 * time.Millisecond,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, conn := newClient(t, client.WithEmitTimeout(50*time.Millisecond))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			if tt.ctxTimeout > 0 {
+				ctx, cancel = context.WithTimeout(ctx, tt.ctxTimeout)
+				defer cancel()
+			}
+
+			if tt.cancel {
+				cancel()
+			}
+
+			if tt.cancelAfter > 0 {
+				time.AfterFunc(tt.cancelAfter, cancel)
+			}
+
+			// the acknowledgement is bounded by the deadline of the emit, it may outlive the test case
+			if ack, wantTimeout := tt.ack, tt.wantTimeout; ack != nil {
+				conn.EXPECT().Ack("getTags", mock.Anything).RunAndReturn(func(event string, timeout time.Duration, args ...any) (any, error) {
+					assert.Greater(t, timeout, time.Duration(0))
+					assert.LessOrEqual(t, timeout, wantTimeout)
+
+					return ack(event, timeout, args...)
+				}).Once()
+			}
+
+			start := time.Now()
+			response, err := c.Emit(ctx, "getTags")
+
+			// the emit does not wait for a blocked acknowledgement
+			assert.Less(t, time.Since(start), time.Second)
+
+			if len(tt.wantErr) == 0 {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, response)
+
+				return
+			}
+
+			assert.Nil(t, response)
+
+			for _, wantErr := range tt.wantErr {
+				assert.ErrorIs(t, err, wantErr)
+			}
+
+			// timeouts are reported as exceeded deadline, cancellations are not
+			assert.Equal(t, errors.Is(err, client.ErrTimeout), errors.Is(err, context.DeadlineExceeded))
+		})
+	}
+}
+
+func TestClient_Await(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   string
+		next    bool
+		before  bool
+		trigger bool
+		cancel  bool
+		close   bool
+		wantErr error
+	}{
+		{
+			name:   "occurred before",
+			event:  handler.ConnectEvent,
+			before: true,
+		},
+		{
+			name:    "occurs while waiting",
+			event:   handler.ConnectEvent,
+			trigger: true,
+		},
+		{
+			name:    "timeout",
+			event:   handler.ConnectEvent,
+			wantErr: client.ErrTimeout,
+		},
+		{
+			name:    "cancelled",
+			event:   handler.ConnectEvent,
+			cancel:  true,
+			wantErr: context.Canceled,
+		},
+		{
+			name:    "closed",
+			event:   handler.ConnectEvent,
+			close:   true,
+			wantErr: client.ErrClosed,
+		},
+		{
+			name:    "unknown event",
+			event:   "unknown",
+			wantErr: client.ErrUnknownEvent,
+		},
+		{
+			name:    "next ignores earlier occurrences",
+			event:   handler.ConnectEvent,
+			next:    true,
+			before:  true,
+			wantErr: client.ErrTimeout,
+		},
+		{
+			name:    "next occurs while waiting",
+			event:   handler.ConnectEvent,
+			next:    true,
+			before:  true,
+			trigger: true,
+		},
+		{
+			name:    "next closed",
+			event:   handler.ConnectEvent,
+			next:    true,
+			close:   true,
+			wantErr: client.ErrClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, conn := newClient(t)
+
+			if tt.before {
+				assert.NoError(t, conn.trigger(t, tt.event))
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			await := c.Await
+			if tt.next {
+				await = c.AwaitNext
+			}
+
+			result := make(chan error, 1)
+			go func() { result <- await(ctx, tt.event) }()
+
+			// give the await a moment to start listening, it must not miss anything after that
+			time.Sleep(5 * time.Millisecond)
+
+			switch {
+			case tt.trigger:
+				assert.NoError(t, conn.trigger(t, tt.event))
+			case tt.cancel:
+				cancel()
+			case tt.close:
+				c.Close()
+			}
+
+			err := waitFor(t, result)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClient_Await_NoLeak(t *testing.T) {
+	c, _ := newClient(t)
+
+	before := runtime.NumGoroutine()
+
+	// cancelled awaits return without leaving anything behind
+	for i := 0; i < 100; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		assert.ErrorIs(t, c.AwaitNext(ctx, handler.ConnectEvent), context.Canceled)
+	}
+
+	// awaits ended by closing the client return as well
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			assert.ErrorIs(t, c.Await(context.Background(), handler.ConnectEvent), client.ErrClosed)
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	c.Close()
+	wg.Wait()
+
+	// assert.Eventually runs the condition in a goroutine of its own, so poll without it
+	for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > before && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	assert.LessOrEqual(t, runtime.NumGoroutine(), before)
+}
+
+func TestClient_RegisterHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		event  string
+		option bool
+	}{
+		{
+			name:  "custom event",
+			event: "custom",
+		},
+		{
+			name:  "override built-in handler",
+			event: handler.HeartbeatEvent,
+		},
+		{
+			name:   "custom event option",
+			event:  "custom",
+			option: true,
+		},
+		{
+			name:   "override built-in handler option",
+			event:  handler.HeartbeatEvent,
+			option: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := state.NewState()
+			p := mocks.NewPublisher(t)
+			raw := handler.NewRaw(tt.event, s, p)
+
+			var opts []client.Option
+			if tt.option {
+				opts = append(opts, client.WithHandlers(raw))
+			}
+
+			c, conn := newClient(t, opts...)
+
+			if !tt.option {
+				assert.NoError(t, c.RegisterHandler(raw))
+			}
+
+			data := map[string]any{"id": 1, "monitorID": 1}
+			p.EXPECT().Publish(tt.event, handler.RawEventData{Event: tt.event, Args: []any{data}}).Once()
+			p.EXPECT().Publish(handler.RawEvent, handler.RawEventData{Event: tt.event, Args: []any{data}}).Once()
+
+			// the event is handled by the custom handler only
+			assert.NoError(t, conn.trigger(t, tt.event, data, nil, nil, nil))
+			assert.NoError(t, c.Await(context.Background(), tt.event))
+
+			_, err := c.State().Heartbeats(1)
+			assert.Error(t, err)
+		})
+	}
+}
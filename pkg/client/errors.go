@@ -1,6 +1,35 @@
 package client
 
-import "errors"
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+)
 
 // ErrTimeout is returned when a timeout occurs.
 var ErrTimeout = errors.New("timeout occurred")
+
+// ErrClosed is returned when the client has been closed.
+var ErrClosed = errors.New("client closed")
+
+// ErrUnknownEvent is returned when awaiting an event without a registered handler.
+var ErrUnknownEvent = errors.New("unknown event")
+
+// contextError returns the error for a done context. A deadline that has been exceeded is reported
+// as ErrTimeout, wrapping the original context error.
+func contextError(ctx context.Context) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %w", ErrTimeout, ctx.Err())
+	}
+
+	return ctx.Err()
+}
+
+// IsTransient returns true if the error of an emit is likely to be transient, e.g. because the
+// acknowledgement timed out during a server pause or a brief disconnect, so the emit may succeed if
+// it is retried. Errors reported by the server itself are never transient.
+func IsTransient(err error) bool {
+	return errors.Is(err, ErrTimeout) || errors.Is(err, shadiaosocketio.ErrorSocketOverflood)
+}
@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"time"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/action"
+)
+
+// Invoker sends an event to the server and returns the acknowledgement.
+type Invoker func(ctx context.Context, event string, args ...any) (any, error)
+
+// Interceptor intercepts every event emitted by the client. It must call the invoker to continue
+// the chain, and may inspect or modify the context, the arguments, the response and the error.
+type Interceptor func(ctx context.Context, event string, args []any, invoker Invoker) (any, error)
+
+// EventInterceptor intercepts every event received by the client. It must call handle to process
+// the event and should return its error, which is otherwise only logged by the client.
+type EventInterceptor func(event string, handle func() error) error
+
+// EmitInfo describes a finished emit, see ObserverInterceptor.
+type EmitInfo struct {
+	// Event is the name of the emitted event, i.e. the action.
+	Event string
+
+	// Args are the arguments sent with the event.
+	Args []any
+
+	// Duration is the time it took to receive the acknowledgement.
+	Duration time.Duration
+
+	// Response is the raw acknowledgement, nil if the emit failed.
+	Response any
+
+	// Ok is the ok flag of the response, false if the response has none.
+	Ok bool
+
+	// Msg is the message of the response, empty if the response has none.
+	Msg string
+
+	// Err is the error returned by the emit.
+	Err error
+}
+
+// chainInterceptors returns an invoker calling the interceptors in the given order before the
+// invoker itself.
+func chainInterceptors(interceptors []Interceptor, invoker Invoker) Invoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+
+		invoker = func(ctx context.Context, event string, args ...any) (any, error) {
+			return interceptor(ctx, event, args, next)
+		}
+	}
+
+	return invoker
+}
+
+// chainEventInterceptors returns an event interceptor calling the interceptors in the given order.
+func chainEventInterceptors(interceptors []EventInterceptor) EventInterceptor {
+	return func(event string, handle func() error) error {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], handle
+
+			handle = func() error {
+				return interceptor(event, next)
+			}
+		}
+
+		return handle()
+	}
+}
+
+// interceptEvent wraps the callback of an event handler, so that it is called through the event
+// interceptor. The returned function has the same signature as the callback, as the socket.io
+// client decodes the event arguments based on it.
+func interceptEvent(interceptor EventInterceptor, event string, callback any) any {
+	fn := reflect.ValueOf(callback)
+	if fn.Kind() != reflect.Func {
+		return callback
+	}
+
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+
+	return reflect.MakeFunc(fn.Type(), func(args []reflect.Value) []reflect.Value {
+		var results []reflect.Value
+
+		err := interceptor(event, func() error {
+			results = fn.Call(args)
+
+			// pass on the error of the callback, if any
+			if n := len(results); n > 0 && results[n-1].Type() == errorType && !results[n-1].IsNil() {
+				return results[n-1].Interface().(error) //nolint:forcetypeassert // checked above
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			slog.Warn("handling event failed", slog.String("event", event), slog.Any("error", err))
+		}
+
+		// the interceptor may skip the callback, return zero values in that case
+		if results == nil {
+			results = make([]reflect.Value, fn.Type().NumOut())
+			for i := range results {
+				results[i] = reflect.Zero(fn.Type().Out(i))
+			}
+		}
+
+		return results
+	}).Interface()
+}
+
+// ObserverInterceptor returns an interceptor that calls the observer after every emit, e.g. to
+// record metrics.
+func ObserverInterceptor(observer func(context.Context, EmitInfo)) Interceptor {
+	return func(ctx context.Context, event string, args []any, invoker Invoker) (any, error) {
+		start := time.Now()
+		response, err := invoker(ctx, event, args...)
+
+		info := EmitInfo{
+			Event:    event,
+			Args:     args,
+			Duration: time.Since(start),
+			Response: response,
+			Err:      err,
+		}
+
+		if err == nil {
+			if status, err := action.DecodeStatus(response); err == nil {
+				info.Ok = status.Ok
+				if status.Msg != nil {
+					info.Msg = *status.Msg
+				}
+			}
+		}
+
+		observer(ctx, info)
+
+		return response, err
+	}
+}
+
+// LoggingInterceptor returns an interceptor that logs every emit with the given logger. The
+// arguments are not logged, as they may contain credentials.
+func LoggingInterceptor(logger *slog.Logger) Interceptor {
+	return ObserverInterceptor(func(ctx context.Context, info EmitInfo) {
+		attrs := []slog.Attr{
+			slog.String("event", info.Event),
+			slog.Duration("duration", info.Duration),
+			slog.Bool("ok", info.Ok),
+		}
+
+		if info.Msg != "" {
+			attrs = append(attrs, slog.String("msg", info.Msg))
+		}
+
+		if info.Err != nil {
+			logger.LogAttrs(ctx, slog.LevelWarn, "emit failed", append(attrs, slog.Any("error", info.Err))...)
+			return
+		}
+
+		logger.LogAttrs(ctx, slog.LevelDebug, "emit finished", attrs...)
+	})
+}
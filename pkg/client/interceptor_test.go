@@ -0,0 +1,197 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/client"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// recordingInterceptor returns an interceptor appending its name to calls before and after the
+// invoker, and the name as additional argument.
+func recordingInterceptor(name string, calls *[]string) client.Interceptor {
+	return func(ctx context.Context, event string, args []any, invoker client.Invoker) (any, error) {
+		*calls = append(*calls, name+" before")
+		response, err := invoker(ctx, event, append(args, name)...)
+		*calls = append(*calls, name+" after")
+
+		return response, err
+	}
+}
+
+// recordingEventInterceptor returns an event interceptor appending its name to calls before and
+// after handling the event.
+func recordingEventInterceptor(name string, calls *[]string) client.EventInterceptor {
+	return func(event string, handle func() error) error {
+		*calls = append(*calls, name+" before "+event)
+		err := handle()
+		*calls = append(*calls, name+" after "+event)
+
+		return err
+	}
+}
+
+func TestWithInterceptors(t *testing.T) {
+	var calls []string
+
+	c, conn := newClient(t,
+		client.WithInterceptors(recordingInterceptor("first", &calls), recordingInterceptor("second", &calls)),
+		client.WithInterceptors(recordingInterceptor("third", &calls)),
+	)
+
+	// the innermost interceptor passes the arguments modified by all outer ones
+	conn.EXPECT().Ack("getTags", mock.Anything, 1, "first", "second", "third").
+		Run(func(string, time.Duration, ...any) { calls = append(calls, "ack") }).
+		Return([]any{[]byte(`{"ok":true}`)}, nil).Once()
+
+	response, err := c.Emit(context.Background(), "getTags", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{[]byte(`{"ok":true}`)}, response)
+
+	assert.Equal(t, []string{
+		"first before",
+		"second before",
+		"third before",
+		"ack",
+		"third after",
+		"second after",
+		"first after",
+	}, calls)
+}
+
+func TestObserverInterceptor(t *testing.T) {
+	errAck := errors.New("ack failed")
+
+	tests := []struct {
+		name     string
+		response any
+		err      error
+		want     client.EmitInfo
+	}{
+		{
+			name:     "ok",
+			response: []any{[]byte(`{"ok":true}`)},
+			want:     client.EmitInfo{Event: "getTags", Args: []any{1}, Response: []any{[]byte(`{"ok":true}`)}, Ok: true},
+		},
+		{
+			name:     "not ok",
+			response: []any{[]byte(`{"ok":false,"msg":"Not found"}`)},
+			want:     client.EmitInfo{Event: "getTags", Args: []any{1}, Response: []any{[]byte(`{"ok":false,"msg":"Not found"}`)}, Msg: "Not found"},
+		},
+		{
+			name: "error",
+			err:  errAck,
+			want: client.EmitInfo{Event: "getTags", Args: []any{1}, Err: errAck},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got client.EmitInfo
+
+			observer := client.ObserverInterceptor(func(ctx context.Context, info client.EmitInfo) {
+				got = info
+			})
+
+			invoker := func(ctx context.Context, event string, args ...any) (any, error) {
+				return tt.response, tt.err
+			}
+
+			response, err := observer(context.Background(), "getTags", []any{1}, invoker)
+			assert.Equal(t, tt.response, response)
+			assert.Equal(t, tt.err, err)
+
+			got.Duration = 0
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWithEventInterceptors(t *testing.T) {
+	heartbeat := map[string]any{"id": 1, "monitorID": 1, "status": true, "msg": "up"}
+
+	tests := []struct {
+		name         string
+		interceptors func(calls *[]string) []client.EventInterceptor
+		args         []any
+		wantCalls    []string
+		wantBeats    []state.Heartbeat
+		wantErr      bool
+	}{
+		{
+			name: "order",
+			interceptors: func(calls *[]string) []client.EventInterceptor {
+				return []client.EventInterceptor{
+					recordingEventInterceptor("first", calls),
+					recordingEventInterceptor("second", calls),
+				}
+			},
+			args: []any{heartbeat},
+			wantCalls: []string{
+				"first before heartbeat",
+				"second before heartbeat",
+				"second after heartbeat",
+				"first after heartbeat",
+			},
+			wantBeats: []state.Heartbeat{{Id: 1, MonitorId: 1, Status: true, Msg: "up"}},
+		},
+		{
+			name: "skip callback",
+			interceptors: func(calls *[]string) []client.EventInterceptor {
+				return []client.EventInterceptor{
+					func(event string, handle func() error) error {
+						*calls = append(*calls, "skipped "+event)
+						return nil
+					},
+				}
+			},
+			args:      []any{heartbeat},
+			wantCalls: []string{"skipped heartbeat"},
+		},
+		{
+			name: "callback error",
+			interceptors: func(calls *[]string) []client.EventInterceptor {
+				return []client.EventInterceptor{
+					func(event string, handle func() error) error {
+						err := handle()
+						if err != nil {
+							*calls = append(*calls, "error "+event)
+						}
+
+						return err
+					},
+				}
+			},
+			args:      []any{"invalid"},
+			wantCalls: []string{"error heartbeat"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+
+			c, conn := newClient(t, client.WithEventInterceptors(tt.interceptors(&calls)...))
+
+			// the wrapped callback receives the original arguments and returns its own results
+			err := conn.trigger(t, handler.HeartbeatEvent, tt.args...)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantCalls, calls)
+
+			beats, _ := c.State().Heartbeats(1)
+			assert.Equal(t, tt.wantBeats, beats)
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	webSocketScheme       = "ws"
+	webSocketSecureScheme = "wss"
+	socketIOPath          = "socket.io"
+)
+
+// Option configures a client, see NewClient.
+type Option func(*options)
+
+// options stores the configuration collected from all options.
+type options struct {
+	// tlsConfig is the TLS configuration used for secure connections.
+	tlsConfig *tls.Config
+
+	// header stores additional headers sent with the websocket handshake.
+	header http.Header
+
+	// cookies stores additional cookies sent with the websocket handshake.
+	cookies []*http.Cookie
+
+	// basePath is the path Uptime Kuma is served under, e.g. behind a reverse proxy.
+	basePath string
+
+	// emitTimeout is the timeout for emitted events if the context has no deadline.
+	emitTimeout time.Duration
+
+	// awaitTimeout is the timeout for awaited events if the context has no deadline.
+	awaitTimeout time.Duration
+
+	// reconnectPolicy configures the reconnect backoff, nil disables reconnecting.
+	reconnectPolicy *ReconnectPolicy
+
+	// handlers are additional handlers registered with the client.
+	handlers []EventHandler
+
+	// interceptors are called for every emitted event, in order.
+	interceptors []Interceptor
+
+	// eventInterceptors are called for every received event, in order.
+	eventInterceptors []EventInterceptor
+
+	// retryPolicy configures retries of idempotent actions, nil disables retries.
+	retryPolicy *RetryPolicy
+}
+
+// newOptions returns the default options with the given options applied.
+func newOptions(opts ...Option) *options {
+	o := &options{
+		header:          http.Header{},
+		emitTimeout:     defaultEmitTimeout,
+		awaitTimeout:    defaultAwaitTimeout,
+		reconnectPolicy: DefaultReconnectPolicy(),
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// tlsClientConfig returns the TLS configuration, creating an empty one if none has been set yet.
+func (o *options) tlsClientConfig() *tls.Config {
+	if o.tlsConfig == nil {
+		o.tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	return o.tlsConfig
+}
+
+// requestHeader returns the headers sent with the websocket handshake, including all cookies.
+func (o *options) requestHeader() http.Header {
+	header := o.header.Clone()
+
+	if len(o.cookies) > 0 {
+		cookies := make([]string, 0, len(o.cookies))
+		for _, cookie := range o.cookies {
+			cookies = append(cookies, cookie.String())
+		}
+
+		header.Set("Cookie", strings.Join(cookies, "; "))
+	}
+
+	return header
+}
+
+// url returns the socket.io websocket url for the given server.
+func (o *options) url(host string, port int, secure bool) string {
+	scheme := webSocketScheme
+	if secure {
+		scheme = webSocketSecureScheme
+	}
+
+	u := url.URL{
+		Scheme:   scheme,
+		Host:     net.JoinHostPort(host, strconv.Itoa(port)),
+		Path:     path.Join("/", o.basePath, socketIOPath) + "/",
+		RawQuery: "transport=websocket",
+	}
+
+	return u.String()
+}
+
+// WithTLSConfig sets the TLS configuration used for secure connections. It replaces any TLS
+// settings made by previous options, so it should be passed first if combined with them.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = config.Clone()
+	}
+}
+
+// WithRootCAs sets the certificate authorities used to verify the server certificate, e.g. for
+// servers using an internal CA.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return func(o *options) {
+		o.tlsClientConfig().RootCAs = pool
+	}
+}
+
+// WithClientCertificate adds a client certificate presented to the server for mutual TLS.
+func WithClientCertificate(cert tls.Certificate) Option {
+	return func(o *options) {
+		o.tlsClientConfig().Certificates = append(o.tlsClientConfig().Certificates, cert)
+	}
+}
+
+// WithInsecureSkipVerify disables the verification of the server certificate. This should only be
+// used for testing.
+func WithInsecureSkipVerify() Option {
+	return func(o *options) {
+		o.tlsClientConfig().InsecureSkipVerify = true //nolint:gosec // explicitly requested by the user
+	}
+}
+
+// WithHeader adds a header sent with the websocket handshake, e.g. for authenticating proxies.
+func WithHeader(key, value string) Option {
+	return func(o *options) {
+		o.header.Add(key, value)
+	}
+}
+
+// WithCookie adds a cookie sent with the websocket handshake, e.g. for authenticating proxies.
+func WithCookie(cookie *http.Cookie) Option {
+	return func(o *options) {
+		o.cookies = append(o.cookies, cookie)
+	}
+}
+
+// WithBasePath sets the path Uptime Kuma is served under, e.g. "/uptime" if a reverse proxy serves
+// it on a sub path.
+func WithBasePath(basePath string) Option {
+	return func(o *options) {
+		o.basePath = basePath
+	}
+}
+
+// WithEmitTimeout sets the timeout for emitted events, used if the context has no deadline.
+func WithEmitTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.emitTimeout = timeout
+	}
+}
+
+// WithAwaitTimeout sets the timeout for awaited events, used if the context has no deadline.
+func WithAwaitTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.awaitTimeout = timeout
+	}
+}
+
+// WithReconnectPolicy sets the reconnect policy of the client. Passing nil disables reconnecting.
+func WithReconnectPolicy(policy *ReconnectPolicy) Option {
+	return func(o *options) {
+		o.reconnectPolicy = policy
+	}
+}
+
+// WithHandlers registers additional handlers with the client before it starts handling events. They
+// replace any handler for the same event, including the built-in ones. See also
+// Client.RegisterHandler.
+func WithHandlers(handlers ...EventHandler) Option {
+	return func(o *options) {
+		o.handlers = append(o.handlers, handlers...)
+	}
+}
+
+// WithInterceptors adds interceptors called for every emitted event, e.g. LoggingInterceptor. They
+// are called in the given order, the first one being the outermost. The emit timeout applies to the
+// innermost call only.
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(o *options) {
+		o.interceptors = append(o.interceptors, interceptors...)
+	}
+}
+
+// WithEventInterceptors adds interceptors called for every event received by the client before it
+// is handled. They are called in the given order, the first one being the outermost.
+func WithEventInterceptors(interceptors ...EventInterceptor) Option {
+	return func(o *options) {
+		o.eventInterceptors = append(o.eventInterceptors, interceptors...)
+	}
+}
+
+// WithRetryPolicy enables retries of idempotent actions that failed with a transient error, e.g.
+// using DefaultRetryPolicy. The retries happen within all other interceptors, so they see a single
+// emit. Passing nil disables retries, which is the default.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *options) {
+		o.retryPolicy = policy
+	}
+}
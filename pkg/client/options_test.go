@@ -0,0 +1,165 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptions_URL(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   []Option
+		host   string
+		secure bool
+		want   string
+	}{
+		{
+			name: "no base path",
+			host: "localhost",
+			want: "ws://localhost:3001/socket.io/?transport=websocket",
+		},
+		{
+			name:   "secure",
+			host:   "localhost",
+			secure: true,
+			want:   "wss://localhost:3001/socket.io/?transport=websocket",
+		},
+		{
+			name: "base path",
+			opts: []Option{WithBasePath("/uptime")},
+			host: "localhost",
+			want: "ws://localhost:3001/uptime/socket.io/?transport=websocket",
+		},
+		{
+			name: "base path without leading slash",
+			opts: []Option{WithBasePath("uptime")},
+			host: "localhost",
+			want: "ws://localhost:3001/uptime/socket.io/?transport=websocket",
+		},
+		{
+			name:   "base path with trailing slash",
+			opts:   []Option{WithBasePath("/uptime/")},
+			host:   "localhost",
+			secure: true,
+			want:   "wss://localhost:3001/uptime/socket.io/?transport=websocket",
+		},
+		{
+			name: "nested base path",
+			opts: []Option{WithBasePath("monitoring/uptime/")},
+			host: "localhost",
+			want: "ws://localhost:3001/monitoring/uptime/socket.io/?transport=websocket",
+		},
+		{
+			name: "ipv6 host",
+			host: "::1",
+			want: "ws://[::1]:3001/socket.io/?transport=websocket",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, newOptions(tt.opts...).url(tt.host, 3001, tt.secure))
+		})
+	}
+}
+
+func TestOptions_RequestHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		want http.Header
+	}{
+		{
+			name: "empty",
+			want: http.Header{},
+		},
+		{
+			name: "headers",
+			opts: []Option{WithHeader("Authorization", "Bearer token"), WithHeader("X-Forwarded-For", "10.0.0.1"), WithHeader("X-Forwarded-For", "10.0.0.2")},
+			want: http.Header{"Authorization": {"Bearer token"}, "X-Forwarded-For": {"10.0.0.1", "10.0.0.2"}},
+		},
+		{
+			name: "cookies",
+			opts: []Option{WithCookie(&http.Cookie{Name: "session", Value: "abc"}), WithCookie(&http.Cookie{Name: "proxy", Value: "xyz"})},
+			want: http.Header{"Cookie": {"session=abc; proxy=xyz"}},
+		},
+		{
+			name: "headers and cookies",
+			opts: []Option{WithCookie(&http.Cookie{Name: "session", Value: "abc"}), WithHeader("Authorization", "Bearer token")},
+			want: http.Header{"Authorization": {"Bearer token"}, "Cookie": {"session=abc"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newOptions(tt.opts...)
+			assert.Equal(t, tt.want, o.requestHeader())
+
+			// the cookies are not added to the configured headers
+			assert.Empty(t, o.header.Get("Cookie"))
+		})
+	}
+}
+
+func TestOptions_TLSClientConfig(t *testing.T) {
+	pool := x509.NewCertPool()
+	first := tls.Certificate{Certificate: [][]byte{{1}}}
+	second := tls.Certificate{Certificate: [][]byte{{2}}}
+	base := &tls.Config{MinVersion: tls.VersionTLS13, ServerName: "uptime.example.com"}
+
+	tests := []struct {
+		name string
+		opts []Option
+		want *tls.Config
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "root cas",
+			opts: []Option{WithRootCAs(pool)},
+			want: &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: pool},
+		},
+		{
+			name: "combined",
+			opts: []Option{WithRootCAs(pool), WithClientCertificate(first), WithClientCertificate(second), WithInsecureSkipVerify()},
+			want: &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: pool, Certificates: []tls.Certificate{first, second}, InsecureSkipVerify: true},
+		},
+		{
+			name: "based on tls config",
+			opts: []Option{WithTLSConfig(base), WithClientCertificate(first), WithInsecureSkipVerify()},
+			want: &tls.Config{MinVersion: tls.VersionTLS13, ServerName: "uptime.example.com", Certificates: []tls.Certificate{first}, InsecureSkipVerify: true},
+		},
+		{
+			name: "tls config replaces previous settings",
+			opts: []Option{WithRootCAs(pool), WithTLSConfig(base)},
+			want: &tls.Config{MinVersion: tls.VersionTLS13, ServerName: "uptime.example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newOptions(tt.opts...).tlsConfig
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+
+			if assert.NotNil(t, got) {
+				assert.Equal(t, tt.want.MinVersion, got.MinVersion)
+				assert.Equal(t, tt.want.ServerName, got.ServerName)
+				assert.Same(t, tt.want.RootCAs, got.RootCAs)
+				assert.Equal(t, tt.want.Certificates, got.Certificates)
+				assert.Equal(t, tt.want.InsecureSkipVerify, got.InsecureSkipVerify)
+			}
+
+			// the given tls config is not modified
+			assert.Empty(t, base.Certificates)
+			assert.False(t, base.InsecureSkipVerify)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/action"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+)
+
+const (
+	defaultReconnectInitialInterval = time.Duration(1) * time.Second
+	defaultReconnectMaxInterval     = time.Duration(30) * time.Second
+	defaultReconnectMultiplier      = 2.0
+
+	// defaultResyncTimeout is the timeout for establishing the new connection and refreshing the
+	// state after a reconnect.
+	defaultResyncTimeout = time.Duration(30) * time.Second
+)
+
+// ReconnectPolicy configures how the client re-dials the server after the connection was lost. The
+// delay before the n-th attempt is InitialInterval * Multiplier^(n-1), capped at MaxInterval.
+type ReconnectPolicy struct {
+	// InitialInterval is the delay before the first reconnect attempt.
+	InitialInterval time.Duration
+
+	// MaxInterval is the upper bound for the delay between two reconnect attempts.
+	MaxInterval time.Duration
+
+	// Multiplier is the factor the delay grows by after every failed attempt.
+	Multiplier float64
+
+	// MaxAttempts is the maximum number of reconnect attempts, 0 means unlimited.
+	MaxAttempts int
+}
+
+// DefaultReconnectPolicy returns the reconnect policy used by clients created with NewClient.
+func DefaultReconnectPolicy() *ReconnectPolicy {
+	return &ReconnectPolicy{
+		InitialInterval: defaultReconnectInitialInterval,
+		MaxInterval:     defaultReconnectMaxInterval,
+		Multiplier:      defaultReconnectMultiplier,
+		MaxAttempts:     0,
+	}
+}
+
+// Backoff returns the delay before the given reconnect attempt, starting at 1.
+func (p *ReconnectPolicy) Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	backoff := float64(p.InitialInterval) * math.Pow(p.Multiplier, float64(attempt-1))
+	if p.MaxInterval > 0 && backoff > float64(p.MaxInterval) {
+		return p.MaxInterval
+	}
+
+	return time.Duration(backoff)
+}
+
+// SetReconnectPolicy sets the reconnect policy of the client, see also WithReconnectPolicy. Passing
+// nil disables reconnecting. Has no effect on clients created with NewClientWithConnection, as they
+// can't re-dial.
+func (c *Client) SetReconnectPolicy(policy *ReconnectPolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.reconnectPolicy = policy
+}
+
+// Reconnected returns a channel that receives a signal after the client has reconnected to the
+// server and refreshed its state. The channel has a buffer of one and signals are dropped if it is
+// full, so multiple reconnects may be reported as a single signal.
+func (c *Client) Reconnected() <-chan struct{} {
+	return c.reconnected
+}
+
+// Reconnect restores a lost connection in the background. It is called by the disconnect handler
+// and does nothing if the client has been closed, can't reconnect or is already reconnecting.
+func (c *Client) Reconnect() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed || c.dial == nil || c.reconnectPolicy == nil || c.reconnecting {
+		return
+	}
+
+	c.reconnecting = true
+
+	go c.reconnect(*c.reconnectPolicy)
+}
+
+// reconnect re-dials the server with backoff until it succeeds, the policy gives up or the client
+// is closed.
+func (c *Client) reconnect(policy ReconnectPolicy) {
+	defer func() {
+		c.mu.Lock()
+		c.reconnecting = false
+		c.mu.Unlock()
+	}()
+
+	for attempt := 1; policy.MaxAttempts == 0 || attempt <= policy.MaxAttempts; attempt++ {
+		select {
+		case <-time.After(policy.Backoff(attempt)):
+		case <-c.done:
+			return
+		}
+
+		slog.Info("reconnecting", slog.Int("attempt", attempt))
+
+		if err := c.redial(); err != nil {
+			slog.Warn("reconnect failed", slog.Int("attempt", attempt), slog.Any("error", err))
+			continue
+		}
+
+		if err := c.resync(); err != nil {
+			slog.Warn("resync after reconnect failed", slog.Int("attempt", attempt), slog.Any("error", err))
+			c.connection().Close()
+
+			continue
+		}
+
+		// signal successful reconnect, drop the signal if nobody consumed the previous one
+		select {
+		case c.reconnected <- struct{}{}:
+		default:
+		}
+
+		slog.Info("reconnected", slog.Int("attempt", attempt))
+
+		return
+	}
+
+	slog.Error("giving up reconnecting", slog.Int("attempts", policy.MaxAttempts))
+}
+
+// redial closes the lost connection, creates a new one and registers all known handlers with it.
+func (c *Client) redial() error {
+	// closing is a no-op if the connection is already closed, it must not hold the lock as the
+	// disconnect handler may be called
+	c.connection().Close()
+
+	socketio, err := c.dial()
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		socketio.Close()
+
+		return ErrClosed
+	}
+
+	c.socketio = socketio
+	c.mu.Unlock()
+
+	// the server resends all heartbeats after login, drop the stale ones to avoid duplicates before
+	// the handlers can receive the new ones
+	if err := c.state.ClearHeartbeats(); err != nil {
+		return err
+	}
+
+	return c.registerHandlers()
+}
+
+// resync waits for the new connection, replays the last login and requests the data kept in the
+// state again, so that the state is consistent with the server.
+func (c *Client) resync() error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultResyncTimeout)
+	defer cancel()
+
+	if err := c.Await(ctx, handler.ConnectEvent); err != nil {
+		return fmt.Errorf("await %s: %w", handler.ConnectEvent, err)
+	}
+
+	// replay the last successful login, if any
+	token, err := c.state.Token()
+
+	switch {
+	case err == nil:
+		if err := action.LoginByToken(ctx, c, token); err != nil {
+			return err
+		}
+	case errors.Is(err, state.ErrNotSetYet):
+		autoLogin, err := c.state.AutoLogin()
+		if err != nil || !autoLogin {
+			// never logged in, so there is nothing to resync
+			return nil
+		}
+	default:
+		return err
+	}
+
+	// request monitors and tags again
+	if err := action.GetMonitorList(ctx, c); err != nil {
+		return err
+	}
+
+	if _, err := action.GetTags(ctx, c); err != nil {
+		return err
+	}
+
+	return nil
+}
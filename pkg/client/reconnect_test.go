@@ -0,0 +1,170 @@
+package client_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/client"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var errDial = errors.New("dial failed")
+
+func TestReconnectPolicy_Backoff(t *testing.T) {
+	policy := &client.ReconnectPolicy{InitialInterval: time.Second, MaxInterval: 10 * time.Second, Multiplier: 2}
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 0, want: time.Second},
+		{attempt: 1, want: time.Second},
+		{attempt: 2, want: 2 * time.Second},
+		{attempt: 4, want: 8 * time.Second},
+		{attempt: 5, want: 10 * time.Second},
+		{attempt: 100, want: 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, policy.Backoff(tt.attempt), "attempt %d", tt.attempt)
+	}
+}
+
+func TestClient_Reconnect(t *testing.T) {
+	policy := &client.ReconnectPolicy{InitialInterval: 5 * time.Millisecond, MaxInterval: time.Second, Multiplier: 2}
+
+	first, second := newFakeConnection(t), newFakeConnection(t)
+
+	dialer := newDialerMock(t)
+	dialer.On("Dial").Return(first, nil).Once()
+
+	c, err := client.NewClientWithDialer(dialer.Dial, client.WithReconnectPolicy(policy))
+	assert.NoError(t, err)
+
+	// connect and log in with the first connection
+	assert.NoError(t, first.trigger(t, handler.ConnectEvent))
+	assert.NoError(t, c.State().SetToken("token"))
+	assert.NoError(t, c.State().SetHeartbeats(1, []state.Heartbeat{{Id: 1, MonitorId: 1}}, true))
+
+	// two failed dials before the second connection succeeds
+	dialer.On("Dial").Return(nil, errDial).Twice()
+	dialer.On("Dial").Return(second, nil).Once()
+
+	// the lost connection is closed before every dial
+	first.EXPECT().Close().Return().Times(3)
+
+	// connect as soon as the handlers of the second connection are registered, the server may push
+	// heartbeats before the connect event is handled
+	go func() {
+		assert.Eventually(t, func() bool {
+			return second.registered(handler.HeartbeatListEvent) && second.registered(handler.ConnectEvent)
+		}, time.Second, time.Millisecond)
+		assert.NoError(t, second.trigger(t, handler.HeartbeatListEvent, 2, []any{map[string]any{"id": 2, "status": 1}}, true))
+		assert.NoError(t, second.trigger(t, handler.ConnectEvent))
+	}()
+
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+
+	record := func(event string) func(string, time.Duration, ...any) {
+		return func(string, time.Duration, ...any) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			calls = append(calls, event)
+		}
+	}
+
+	second.EXPECT().Ack("loginByToken", mock.Anything, "token").Run(record("loginByToken")).
+		Return([]any{[]byte(`{"ok":true}`)}, nil).Once()
+	second.EXPECT().Ack("getMonitorList", mock.Anything).Run(record("getMonitorList")).
+		Return([]any{[]byte(`{"ok":true}`)}, nil).Once()
+	second.EXPECT().Ack("getTags", mock.Anything).Run(record("getTags")).
+		Return([]any{[]byte(`{"ok":true,"tags":[]}`)}, nil).Once()
+
+	start := time.Now()
+	assert.NoError(t, first.trigger(t, handler.DisconnectEvent, handler.DisconnectReason{Code: 1006}))
+
+	waitFor(t, c.Reconnected())
+
+	// every attempt waits for its backoff
+	assert.GreaterOrEqual(t, time.Since(start), policy.Backoff(1)+policy.Backoff(2)+policy.Backoff(3))
+
+	// the state is resynced in order
+	mu.Lock()
+	assert.Equal(t, []string{"loginByToken", "getMonitorList", "getTags"}, calls)
+	mu.Unlock()
+
+	// the stale heartbeats are dropped, the resent ones are kept
+	_, err = c.State().Heartbeats(1)
+	assert.Error(t, err)
+
+	beats, err := c.State().Heartbeats(2)
+	assert.NoError(t, err)
+	assert.Len(t, beats, 1)
+
+	second.EXPECT().Close().Return().Once()
+	c.Close()
+}
+
+func TestClient_Reconnect_GiveUp(t *testing.T) {
+	policy := &client.ReconnectPolicy{InitialInterval: time.Millisecond, Multiplier: 1, MaxAttempts: 2}
+
+	conn := newFakeConnection(t)
+
+	dialer := newDialerMock(t)
+	dialer.On("Dial").Return(conn, nil).Once()
+
+	c, err := client.NewClientWithDialer(dialer.Dial, client.WithReconnectPolicy(policy))
+	assert.NoError(t, err)
+
+	failed := make(chan struct{}, policy.MaxAttempts)
+
+	dialer.On("Dial").Run(func(mock.Arguments) { failed <- struct{}{} }).Return(nil, errDial).Times(policy.MaxAttempts)
+	conn.EXPECT().Close().Return()
+
+	assert.NoError(t, conn.trigger(t, handler.ConnectEvent))
+	assert.NoError(t, conn.trigger(t, handler.DisconnectEvent, handler.DisconnectReason{Code: 1006}))
+
+	for i := 0; i < policy.MaxAttempts; i++ {
+		waitFor(t, failed)
+	}
+
+	select {
+	case <-c.Reconnected():
+		t.Fatal("reconnected without a connection")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	c.Close()
+}
+
+func TestClient_Reconnect_Closed(t *testing.T) {
+	conn := newFakeConnection(t)
+
+	// the dialer is not called again once the client is closed
+	dialer := newDialerMock(t)
+	dialer.On("Dial").Return(conn, nil).Once()
+
+	c, err := client.NewClientWithDialer(dialer.Dial, client.WithReconnectPolicy(client.DefaultReconnectPolicy()))
+	assert.NoError(t, err)
+
+	conn.EXPECT().Close().Return().Once()
+	c.Close()
+
+	assert.NoError(t, conn.trigger(t, handler.ConnectEvent))
+	assert.NoError(t, conn.trigger(t, handler.DisconnectEvent, handler.DisconnectReason{Code: 1006}))
+
+	select {
+	case <-c.Reconnected():
+		t.Fatal("closed client reconnected")
+	case <-time.After(20 * time.Millisecond):
+	}
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/action"
+)
+
+const (
+	defaultRetryMaxAttempts     = 3
+	defaultRetryInitialInterval = time.Duration(250) * time.Millisecond
+	defaultRetryMaxInterval     = time.Duration(5) * time.Second
+	defaultRetryMultiplier      = 2.0
+	defaultRetryJitter          = 0.5
+)
+
+// RetryPolicy configures how the client retries actions that failed with a transient error, see
+// IsTransient. Only idempotent actions are retried, see action.IsIdempotent. The delay before the
+// n-th retry is InitialInterval * Multiplier^(n-1), capped at MaxInterval and reduced by a random
+// jitter.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	MaxAttempts int
+
+	// InitialInterval is the delay before the first retry.
+	InitialInterval time.Duration
+
+	// MaxInterval is the upper bound for the delay between two attempts.
+	MaxInterval time.Duration
+
+	// Multiplier is the factor the delay grows by after every failed attempt.
+	Multiplier float64
+
+	// Jitter is the fraction of the delay, between 0 and 1, that is randomly subtracted from it to
+	// spread the retries of multiple clients.
+	Jitter float64
+
+	// Retryable decides whether an action may be retried, defaults to action.IsIdempotent.
+	Retryable func(action string) bool
+}
+
+// DefaultRetryPolicy returns the default retry policy, see WithRetryPolicy.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:     defaultRetryMaxAttempts,
+		InitialInterval: defaultRetryInitialInterval,
+		MaxInterval:     defaultRetryMaxInterval,
+		Multiplier:      defaultRetryMultiplier,
+		Jitter:          defaultRetryJitter,
+		Retryable:       action.IsIdempotent,
+	}
+}
+
+// Backoff returns the delay before the given retry, starting at 1, including the jitter.
+func (p *RetryPolicy) Backoff(retry int) time.Duration {
+	if retry < 1 {
+		retry = 1
+	}
+
+	backoff := float64(p.InitialInterval) * math.Pow(p.Multiplier, float64(retry-1))
+	if p.MaxInterval > 0 && backoff > float64(p.MaxInterval) {
+		backoff = float64(p.MaxInterval)
+	}
+
+	if p.Jitter > 0 {
+		backoff -= backoff * math.Min(p.Jitter, 1) * rand.Float64() //nolint:gosec // jitter does not need a secure source
+	}
+
+	return time.Duration(backoff)
+}
+
+// retryable returns true if the given action may be retried.
+func (p *RetryPolicy) retryable(event string) bool {
+	if p.Retryable == nil {
+		return action.IsIdempotent(event)
+	}
+
+	return p.Retryable(event)
+}
+
+// RetryInterceptor returns an interceptor that retries actions according to the given policy. As
+// the emit timeout applies to every attempt, the context deadline should account for all attempts.
+func RetryInterceptor(policy *RetryPolicy) Interceptor {
+	return func(ctx context.Context, event string, args []any, invoker Invoker) (any, error) {
+		response, err := invoker(ctx, event, args...)
+
+		for attempt := 2; attempt <= policy.MaxAttempts; attempt++ {
+			if err == nil || !IsTransient(err) || !policy.retryable(event) || ctx.Err() != nil {
+				break
+			}
+
+			slog.Debug("retrying action", slog.String("action", event), slog.Int("attempt", attempt), slog.Any("error", err))
+
+			select {
+			case <-time.After(policy.Backoff(attempt - 1)):
+			case <-ctx.Done():
+				return nil, contextError(ctx)
+			}
+
+			response, err = invoker(ctx, event, args...)
+		}
+
+		return response, err
+	}
+}
@@ -0,0 +1,239 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *RetryPolicy
+		retry  int
+		min    time.Duration
+		max    time.Duration
+	}{
+		{
+			name:   "first retry",
+			policy: &RetryPolicy{InitialInterval: 100 * time.Millisecond, MaxInterval: time.Second, Multiplier: 2},
+			retry:  1,
+			min:    100 * time.Millisecond,
+			max:    100 * time.Millisecond,
+		},
+		{
+			name:   "retry below one",
+			policy: &RetryPolicy{InitialInterval: 100 * time.Millisecond, MaxInterval: time.Second, Multiplier: 2},
+			retry:  0,
+			min:    100 * time.Millisecond,
+			max:    100 * time.Millisecond,
+		},
+		{
+			name:   "grows with multiplier",
+			policy: &RetryPolicy{InitialInterval: 100 * time.Millisecond, MaxInterval: time.Second, Multiplier: 2},
+			retry:  3,
+			min:    400 * time.Millisecond,
+			max:    400 * time.Millisecond,
+		},
+		{
+			name:   "capped at max interval",
+			policy: &RetryPolicy{InitialInterval: 100 * time.Millisecond, MaxInterval: time.Second, Multiplier: 2},
+			retry:  10,
+			min:    time.Second,
+			max:    time.Second,
+		},
+		{
+			name:   "no max interval",
+			policy: &RetryPolicy{InitialInterval: 100 * time.Millisecond, Multiplier: 2},
+			retry:  5,
+			min:    1600 * time.Millisecond,
+			max:    1600 * time.Millisecond,
+		},
+		{
+			name:   "jitter",
+			policy: &RetryPolicy{InitialInterval: 100 * time.Millisecond, MaxInterval: time.Second, Multiplier: 2, Jitter: 0.5},
+			retry:  2,
+			min:    100 * time.Millisecond,
+			max:    200 * time.Millisecond,
+		},
+		{
+			name:   "jitter above one",
+			policy: &RetryPolicy{InitialInterval: 100 * time.Millisecond, MaxInterval: time.Second, Multiplier: 2, Jitter: 2},
+			retry:  1,
+			min:    0,
+			max:    100 * time.Millisecond,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				backoff := tt.policy.Backoff(tt.retry)
+				assert.GreaterOrEqual(t, backoff, tt.min)
+				assert.LessOrEqual(t, backoff, tt.max)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_retryable(t *testing.T) {
+	// defaults to action.IsIdempotent
+	policy := &RetryPolicy{}
+	assert.True(t, policy.retryable("getMonitorList"))
+	assert.False(t, policy.retryable("add"))
+
+	policy.Retryable = func(event string) bool { return event == "add" }
+	assert.False(t, policy.retryable("getMonitorList"))
+	assert.True(t, policy.retryable("add"))
+}
+
+func TestRetryInterceptor(t *testing.T) {
+	errTransient := fmt.Errorf("%w: no acknowledgement", ErrTimeout)
+	errServer := errors.New("server error") //nolint:goerr113 // test error
+
+	tests := []struct {
+		name      string
+		policy    *RetryPolicy
+		event     string
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "ok",
+			policy:    &RetryPolicy{MaxAttempts: 3, InitialInterval: time.Millisecond},
+			event:     "getMonitorList",
+			errs:      []error{nil},
+			wantCalls: 1,
+		},
+		{
+			name:      "transient error",
+			policy:    &RetryPolicy{MaxAttempts: 3, InitialInterval: time.Millisecond},
+			event:     "getMonitorList",
+			errs:      []error{errTransient, nil},
+			wantCalls: 2,
+		},
+		{
+			name:      "max attempts",
+			policy:    &RetryPolicy{MaxAttempts: 3, InitialInterval: time.Millisecond},
+			event:     "getMonitorList",
+			errs:      []error{errTransient, errTransient, errTransient},
+			wantCalls: 3,
+			wantErr:   ErrTimeout,
+		},
+		{
+			name:      "no retries",
+			policy:    &RetryPolicy{MaxAttempts: 1, InitialInterval: time.Millisecond},
+			event:     "getMonitorList",
+			errs:      []error{errTransient},
+			wantCalls: 1,
+			wantErr:   ErrTimeout,
+		},
+		{
+			name:      "not transient",
+			policy:    &RetryPolicy{MaxAttempts: 3, InitialInterval: time.Millisecond},
+			event:     "getMonitorList",
+			errs:      []error{errServer},
+			wantCalls: 1,
+			wantErr:   errServer,
+		},
+		{
+			name:      "not idempotent",
+			policy:    &RetryPolicy{MaxAttempts: 3, InitialInterval: time.Millisecond},
+			event:     "add",
+			errs:      []error{errTransient},
+			wantCalls: 1,
+			wantErr:   ErrTimeout,
+		},
+		{
+			name:      "custom retryable",
+			policy:    &RetryPolicy{MaxAttempts: 3, InitialInterval: time.Millisecond, Retryable: func(string) bool { return true }},
+			event:     "add",
+			errs:      []error{errTransient, nil},
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			invoker := func(ctx context.Context, event string, args ...any) (any, error) {
+				assert.Equal(t, tt.event, event)
+				assert.Equal(t, []any{1}, args)
+
+				err := tt.errs[calls]
+				calls++
+
+				if err != nil {
+					return nil, err
+				}
+
+				return "response", nil
+			}
+
+			response, err := RetryInterceptor(tt.policy)(context.Background(), tt.event, []any{1}, invoker)
+			assert.Equal(t, tt.wantCalls, calls)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, response)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "response", response)
+			}
+		})
+	}
+}
+
+func TestRetryInterceptor_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := 0
+	invoker := func(ctx context.Context, event string, args ...any) (any, error) {
+		calls++
+		cancel()
+
+		return nil, ErrTimeout
+	}
+
+	policy := &RetryPolicy{MaxAttempts: 3, InitialInterval: time.Hour}
+
+	// no retry once the context is done, the error of the attempt is returned
+	_, err := RetryInterceptor(policy)(ctx, "getMonitorList", nil, invoker)
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.Equal(t, 1, calls)
+
+	// the backoff is aborted once the context is done
+	ctx, cancel = context.WithCancel(context.Background())
+	calls = 0
+	invoker = func(ctx context.Context, event string, args ...any) (any, error) {
+		calls++
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+
+		return nil, ErrTimeout
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		_, err := RetryInterceptor(policy)(ctx, "getMonitorList", nil, invoker)
+		assert.ErrorIs(t, err, context.Canceled)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("retry did not stop on context cancellation")
+	}
+
+	assert.Equal(t, 1, calls)
+}
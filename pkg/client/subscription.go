@@ -0,0 +1,279 @@
+package client
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+)
+
+// defaultSubscriptionBuffer is the buffer size of a subscription if none is given.
+const defaultSubscriptionBuffer = 16
+
+// Subscription delivers the data of an event to a buffered channel. Delivery never blocks the
+// client: if the buffer is full, the data is dropped and counted instead.
+type Subscription[T any] struct {
+	c       chan T
+	dropped atomic.Uint64
+	cancel  func()
+	once    sync.Once
+}
+
+// C returns the channel the event data is delivered to. The channel is closed after Unsubscribe is
+// called or the client is closed.
+func (s *Subscription[T]) C() <-chan T {
+	return s.c
+}
+
+// Dropped returns the number of events dropped because the buffer was full.
+func (s *Subscription[T]) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Unsubscribe stops the delivery of events and closes the channel. It is safe to call it multiple
+// times.
+func (s *Subscription[T]) Unsubscribe() {
+	s.once.Do(s.cancel)
+}
+
+// subscriber is a single subscription registered with the broker.
+type subscriber struct {
+	deliver func(any)
+	close   func()
+}
+
+// broker fans out the data published by the handlers to all subscriptions of an event.
+type broker struct {
+	mu          sync.RWMutex
+	next        uint64
+	closed      bool
+	subscribers map[string]map[uint64]subscriber
+}
+
+// newBroker returns a new broker without any subscriptions.
+func newBroker() *broker {
+	return &broker{subscribers: map[string]map[uint64]subscriber{}}
+}
+
+// Publish delivers the data to all subscriptions of the given event.
+func (b *broker) Publish(event string, data any) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, s := range b.subscribers[event] {
+		s.deliver(data)
+	}
+}
+
+// add registers a subscriber for the given event and returns its id. If the broker has already
+// been closed, the subscriber is closed right away.
+func (b *broker) add(event string, s subscriber) uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.next++
+
+	if b.closed {
+		s.close()
+		return b.next
+	}
+
+	if b.subscribers[event] == nil {
+		b.subscribers[event] = map[uint64]subscriber{}
+	}
+
+	b.subscribers[event][b.next] = s
+
+	return b.next
+}
+
+// remove unregisters and closes the subscriber with the given id, if it is still registered.
+func (b *broker) remove(event string, id uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if s, ok := b.subscribers[event][id]; ok {
+		delete(b.subscribers[event], id)
+		s.close()
+	}
+}
+
+// close unregisters and closes all subscribers.
+func (b *broker) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+
+	for event, subscribers := range b.subscribers {
+		for _, s := range subscribers {
+			s.close()
+		}
+
+		delete(b.subscribers, event)
+	}
+}
+
+// notify returns a channel that is signalled whenever the given event is published, and a function
+// to stop the notifications. Signals are coalesced, the channel is closed if the broker is closed.
+func (b *broker) notify(event string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	id := b.add(event, subscriber{
+		deliver: func(any) {
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		},
+		close: func() {
+			close(ch)
+		},
+	})
+
+	return ch, func() {
+		b.remove(event, id)
+	}
+}
+
+// subscribe creates a new subscription for the given event, delivering all data of type T.
+func subscribe[T any](b *broker, event string, buffer int) *Subscription[T] {
+	if buffer < 1 {
+		buffer = defaultSubscriptionBuffer
+	}
+
+	s := &Subscription[T]{c: make(chan T, buffer)}
+
+	id := b.add(event, subscriber{
+		deliver: func(data any) {
+			v, ok := data.(T)
+			if !ok {
+				return
+			}
+
+			select {
+			case s.c <- v:
+			default:
+				s.dropped.Add(1)
+			}
+		},
+		close: func() {
+			close(s.c)
+		},
+	})
+
+	s.cancel = func() {
+		b.remove(event, id)
+	}
+
+	return s
+}
+
+// Subscribe subscribes to the data published for the given event, e.g. by a custom handler. Prefer
+// the typed subscriptions for the built-in events. A buffer smaller than one uses the default
+// buffer size.
+func (c *Client) Subscribe(event string, buffer int) *Subscription[any] {
+	return subscribe[any](c.events, event, buffer)
+}
+
+// SubscribeRaw subscribes to the events listed in handler.RawEvents, to all message events and to
+// the events of custom handlers created with handler.NewRaw. Other events without a handler are
+// dropped by the socket.io client and never delivered, register a raw handler for them with
+// WithHandlers or RegisterHandler. A buffer smaller than one uses the default buffer size.
+func (c *Client) SubscribeRaw(buffer int) *Subscription[handler.RawEventData] {
+	return subscribe[handler.RawEventData](c.events, handler.RawEvent, buffer)
+}
+
+// SubscribeHeartbeats subscribes to every heartbeat sent by the server. A buffer smaller than one
+// uses the default buffer size.
+func (c *Client) SubscribeHeartbeats(buffer int) *Subscription[state.Heartbeat] {
+	return subscribe[state.Heartbeat](c.events, handler.HeartbeatEvent, buffer)
+}
+
+// SubscribeHeartbeatLists subscribes to the heartbeat lists sent by the server, e.g. after login.
+// A buffer smaller than one uses the default buffer size.
+func (c *Client) SubscribeHeartbeatLists(buffer int) *Subscription[handler.HeartbeatListData] {
+	return subscribe[handler.HeartbeatListData](c.events, handler.HeartbeatListEvent, buffer)
+}
+
+// SubscribeImportantHeartbeatLists subscribes to the important heartbeat lists sent by the server.
+// A buffer smaller than one uses the default buffer size.
+func (c *Client) SubscribeImportantHeartbeatLists(buffer int) *Subscription[handler.HeartbeatListData] {
+	return subscribe[handler.HeartbeatListData](c.events, handler.ImportantHeartbeatListEvent, buffer)
+}
+
+// SubscribeMonitorList subscribes to the monitor lists sent by the server. Each list contains all
+// monitors, keyed by their id. The monitors are shared with the state and must not be modified. A
+// buffer smaller than one uses the default buffer size.
+func (c *Client) SubscribeMonitorList(buffer int) *Subscription[map[int]*state.Monitor] {
+	return subscribe[map[int]*state.Monitor](c.events, handler.MonitorListEvent, buffer)
+}
+
+// SubscribeMaintenanceList subscribes to the maintenance lists sent by the server. Each list
+// contains all maintenances, keyed by their id. The maintenances are shared with the state and must
+// not be modified. A buffer smaller than one uses the default buffer size.
+func (c *Client) SubscribeMaintenanceList(buffer int) *Subscription[map[int]*state.Maintenance] {
+	return subscribe[map[int]*state.Maintenance](c.events, handler.MaintenanceListEvent, buffer)
+}
+
+// SubscribeNotificationList subscribes to the notification lists sent by the server. Each list
+// contains all notifications. A buffer smaller than one uses the default buffer size.
+func (c *Client) SubscribeNotificationList(buffer int) *Subscription[[]state.Notification] {
+	return subscribe[[]state.Notification](c.events, handler.NotificationListEvent, buffer)
+}
+
+// SubscribeProxyList subscribes to the proxy lists sent by the server. Each list contains all
+// proxies. A buffer smaller than one uses the default buffer size.
+func (c *Client) SubscribeProxyList(buffer int) *Subscription[[]state.Proxy] {
+	return subscribe[[]state.Proxy](c.events, handler.ProxyListEvent, buffer)
+}
+
+// SubscribeStatusPageList subscribes to the status page lists sent by the server. Each list
+// contains all status pages, keyed by their id. The status pages are shared with the state and must
+// not be modified. A buffer smaller than one uses the default buffer size.
+func (c *Client) SubscribeStatusPageList(buffer int) *Subscription[map[int]*state.StatusPage] {
+	return subscribe[map[int]*state.StatusPage](c.events, handler.StatusPageListEvent, buffer)
+}
+
+// SubscribeAPIKeyList subscribes to the API key lists sent by the server. Each list contains all
+// API keys. A buffer smaller than one uses the default buffer size.
+func (c *Client) SubscribeAPIKeyList(buffer int) *Subscription[[]state.APIKey] {
+	return subscribe[[]state.APIKey](c.events, handler.APIKeyListEvent, buffer)
+}
+
+// SubscribeDockerHostList subscribes to the docker host lists sent by the server. Each list
+// contains all docker hosts. A buffer smaller than one uses the default buffer size.
+func (c *Client) SubscribeDockerHostList(buffer int) *Subscription[[]state.DockerHost] {
+	return subscribe[[]state.DockerHost](c.events, handler.DockerHostListEvent, buffer)
+}
+
+// SubscribeUptime subscribes to the uptimes sent by the server. Each uptime is sent for a single
+// monitor and window. A buffer smaller than one uses the default buffer size.
+func (c *Client) SubscribeUptime(buffer int) *Subscription[handler.UptimeData] {
+	return subscribe[handler.UptimeData](c.events, handler.UptimeEvent, buffer)
+}
+
+// SubscribeAvgPing subscribes to the average pings sent by the server. A buffer smaller than one
+// uses the default buffer size.
+func (c *Client) SubscribeAvgPing(buffer int) *Subscription[handler.AvgPingData] {
+	return subscribe[handler.AvgPingData](c.events, handler.AvgPingEvent, buffer)
+}
+
+// SubscribeCertInfo subscribes to the TLS information of monitors sent by the server. A buffer
+// smaller than one uses the default buffer size.
+func (c *Client) SubscribeCertInfo(buffer int) *Subscription[handler.CertInfoData] {
+	return subscribe[handler.CertInfoData](c.events, handler.CertInfoEvent, buffer)
+}
+
+// SubscribeInfo subscribes to the server info sent by the server. A buffer smaller than one uses the
+// default buffer size.
+func (c *Client) SubscribeInfo(buffer int) *Subscription[state.Info] {
+	return subscribe[state.Info](c.events, handler.InfoEvent, buffer)
+}
+
+// SubscribeDisconnect subscribes to disconnects from the server. A buffer smaller than one uses the
+// default buffer size.
+func (c *Client) SubscribeDisconnect(buffer int) *Subscription[handler.DisconnectReason] {
+	return subscribe[handler.DisconnectReason](c.events, handler.DisconnectEvent, buffer)
+}
@@ -0,0 +1,158 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/client"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/stretchr/testify/assert"
+)
+
+// newClient returns a client using a fake connection, that is closed when the test finishes.
+func newClient(t *testing.T, opts ...client.Option) (*client.Client, *fakeConnection) {
+	conn := newFakeConnection(t)
+
+	c, err := client.NewClientWithConnection(conn, opts...)
+	assert.NoError(t, err)
+
+	conn.EXPECT().Close().Return().Maybe()
+	t.Cleanup(c.Close)
+
+	return c, conn
+}
+
+// drain returns all values buffered in the channel, and whether the channel has been closed.
+func drain[T any](ch <-chan T) ([]T, bool) {
+	var values []T
+
+	for {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return values, true
+			}
+
+			values = append(values, v)
+		default:
+			return values, false
+		}
+	}
+}
+
+func TestSubscription(t *testing.T) {
+	tests := []struct {
+		name        string
+		buffer      int
+		publish     []any
+		want        []any
+		wantDropped uint64
+	}{
+		{
+			name:    "empty",
+			buffer:  2,
+			publish: nil,
+			want:    nil,
+		},
+		{
+			name:    "within buffer",
+			buffer:  2,
+			publish: []any{1, 2},
+			want:    []any{1, 2},
+		},
+		{
+			name:        "buffer full",
+			buffer:      2,
+			publish:     []any{1, 2, 3, 4, 5},
+			want:        []any{1, 2},
+			wantDropped: 3,
+		},
+		{
+			name:        "default buffer",
+			buffer:      0,
+			publish:     []any{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17},
+			want:        []any{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			wantDropped: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newClient(t)
+
+			s := c.Subscribe("custom", tt.buffer)
+			defer s.Unsubscribe()
+
+			for _, data := range tt.publish {
+				c.Publish("custom", data)
+			}
+
+			// data of other events is not delivered
+			c.Publish("other", 0)
+
+			got, closed := drain(s.C())
+			assert.False(t, closed)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantDropped, s.Dropped())
+		})
+	}
+}
+
+func TestSubscription_Typed(t *testing.T) {
+	c, _ := newClient(t)
+
+	s := c.SubscribeHeartbeats(1)
+	defer s.Unsubscribe()
+
+	// data of another type is skipped and not counted as dropped
+	c.Publish(handler.HeartbeatEvent, "invalid")
+	c.Publish(handler.HeartbeatEvent, state.Heartbeat{Id: 1})
+
+	got, _ := drain(s.C())
+	assert.Equal(t, []state.Heartbeat{{Id: 1}}, got)
+	assert.Zero(t, s.Dropped())
+}
+
+func TestSubscription_Unsubscribe(t *testing.T) {
+	c, _ := newClient(t)
+
+	s := c.Subscribe("custom", 1)
+	other := c.Subscribe("custom", 1)
+	defer other.Unsubscribe()
+
+	c.Publish("custom", 1)
+
+	// unsubscribing twice is safe, buffered data is still received before the channel is closed
+	s.Unsubscribe()
+	s.Unsubscribe()
+
+	c.Publish("custom", 2)
+
+	got, closed := drain(s.C())
+	assert.True(t, closed)
+	assert.Equal(t, []any{1}, got)
+
+	// other subscriptions of the event are not affected
+	got, closed = drain(other.C())
+	assert.False(t, closed)
+	assert.Equal(t, []any{1}, got)
+}
+
+func TestSubscription_Close(t *testing.T) {
+	c, _ := newClient(t)
+
+	s := c.Subscribe("custom", 1)
+	c.Close()
+
+	_, closed := drain(s.C())
+	assert.True(t, closed)
+
+	// subscriptions of a closed client are closed right away
+	s = c.Subscribe("custom", 1)
+
+	_, closed = drain(s.C())
+	assert.True(t, closed)
+
+	// unsubscribing after close is safe
+	s.Unsubscribe()
+}
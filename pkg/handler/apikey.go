@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+const (
+	APIKeyListEvent = "apiKeyList"
+)
+
+type APIKeyState interface {
+	SetAPIKeys(apiKeys []state.APIKey) (err error)
+	HasSeen(event string) (seen bool)
+	MarkSeen(event string)
+}
+
+type APIKeyList struct {
+	state     APIKeyState
+	publisher Publisher
+}
+
+// NewAPIKeyList creates a new APIKeyList handler. The publisher is optional and receives the
+// processed API keys as []state.APIKey.
+func NewAPIKeyList(state APIKeyState, publisher Publisher) *APIKeyList {
+	return &APIKeyList{state: state, publisher: publisher}
+}
+
+func (al APIKeyList) Event() string {
+	return APIKeyListEvent
+}
+
+func (al APIKeyList) Register(h HandlerRegistrator) error {
+	return h.On(APIKeyListEvent, al.Callback)
+}
+
+func (al APIKeyList) Occurred() bool {
+	return al.state.HasSeen(APIKeyListEvent)
+}
+
+func (al APIKeyList) Callback(ch *shadiaosocketio.Channel, data any) error {
+	al.state.MarkSeen(APIKeyListEvent)
+
+	// assert data type
+	typedData, ok := data.([]any)
+	if !ok {
+		return NewErrInvalidDataType("[]any", data)
+	}
+
+	// decode data into slice of api keys
+	apiKeys := make([]state.APIKey, 0, len(typedData))
+
+	apiKeys, err := utils.DecodeSlice(typedData, apiKeys)
+	if err != nil {
+		return fmt.Errorf("decode failed: %w", err)
+	}
+
+	// set api keys
+	if err := al.state.SetAPIKeys(apiKeys); err != nil {
+		return err
+	}
+
+	// publish api keys
+	if al.publisher != nil {
+		al.publisher.Publish(APIKeyListEvent, apiKeys)
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAPIKeyList_Event(t *testing.T) {
+	c := handler.NewAPIKeyList(nil, nil)
+
+	assert.Equal(t, handler.APIKeyListEvent, c.Event())
+}
+
+func TestAPIKeyList_Register(t *testing.T) {
+	r := mocks.NewHandlerRegistrator(t)
+	c := handler.NewAPIKeyList(nil, nil)
+
+	r.EXPECT().On(handler.APIKeyListEvent, mock.MatchedBy(func(any) bool {
+		return true
+	})).Return(nil).Once()
+
+	assert.NoError(t, c.Register(r))
+}
+
+func TestAPIKeyList_Occurred(t *testing.T) {
+	s := mocks.NewAPIKeyState(t)
+	c := handler.NewAPIKeyList(s, nil)
+
+	s.EXPECT().HasSeen(handler.APIKeyListEvent).Return(false).Once()
+	s.EXPECT().HasSeen(handler.APIKeyListEvent).Return(true).Once()
+
+	assert.False(t, c.Occurred())
+	assert.True(t, c.Occurred())
+}
+
+func TestAPIKeyList_Callback(t *testing.T) {
+	type fields struct {
+		state     *mocks.APIKeyState
+		publisher *mocks.Publisher
+	}
+
+	type args struct {
+		ch   *shadiaosocketio.Channel
+		data any
+	}
+
+	apiKey := map[string]any{
+		"id":          1,
+		"name":        "prometheus",
+		"userID":      1,
+		"createdDate": "2023-10-01 12:00:00",
+		"active":      true,
+		"expires":     "2024-10-01 00:00:00",
+		"status":      "active",
+	}
+
+	expires := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	want := []state.APIKey{
+		{
+			Id:          1,
+			Name:        "prometheus",
+			UserId:      1,
+			CreatedDate: time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC),
+			Active:      true,
+			Status:      state.APIKeyStatusActive,
+			Expires:     &expires,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		fields *fields
+		args   *args
+		want   *string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "ok",
+			fields: &fields{
+				state: mocks.NewAPIKeyState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{apiKey},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.APIKeyListEvent).Return().Once()
+				f.state.EXPECT().SetAPIKeys(want).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "empty",
+			fields: &fields{
+				state: mocks.NewAPIKeyState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.APIKeyListEvent).Return().Once()
+				f.state.EXPECT().SetAPIKeys([]state.APIKey{}).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "publish",
+			fields: &fields{
+				state:     mocks.NewAPIKeyState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{apiKey},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.APIKeyListEvent).Return().Once()
+				f.state.EXPECT().SetAPIKeys(want).Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.APIKeyListEvent, want).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
+		{
+			name: "invalid data",
+			fields: &fields{
+				state: mocks.NewAPIKeyState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: "invalid data",
+			},
+			want: utils.NewString("invalid data type"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.APIKeyListEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetAPIKeys", mock.Anything)
+			},
+		},
+		{
+			name: "decode failed",
+			fields: &fields{
+				state: mocks.NewAPIKeyState(t),
+			},
+			args: &args{
+				ch: &shadiaosocketio.Channel{},
+				data: []any{
+					map[string]any{"id": "one"},
+				},
+			},
+			want: utils.NewString("decode failed"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.APIKeyListEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetAPIKeys", mock.Anything)
+			},
+		},
+		{
+			name: "set api keys failed",
+			fields: &fields{
+				state: mocks.NewAPIKeyState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{},
+			},
+			want: utils.NewString(state.ErrStateNil.Error()),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.APIKeyListEvent).Return().Once()
+				f.state.EXPECT().SetAPIKeys([]state.APIKey{}).Return(state.ErrStateNil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// setup mocks
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewAPIKeyList(tt.fields.state, publisher)
+
+			if tt.on != nil {
+				tt.on(tt.fields)
+			}
+
+			// run function
+			got := c.Callback(tt.args.ch, tt.args.data)
+
+			// assert results
+			if tt.want != nil && assert.NotNil(t, got) {
+				assert.ErrorContains(t, got, *tt.want)
+			}
+
+			if tt.want == nil {
+				assert.NoError(t, got)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, tt.fields)
+			}
+		})
+	}
+}
@@ -17,12 +17,14 @@ type AutoLoginState interface {
 }
 
 type AutoLogin struct {
-	state AutoLoginState
+	state     AutoLoginState
+	publisher Publisher
 }
 
-// NewAutoLogin creates a new AutoLogin handler.
-func NewAutoLogin(state AutoLoginState) *AutoLogin {
-	return &AutoLogin{state: state}
+// NewAutoLogin creates a new AutoLogin handler. The publisher is optional and is notified without
+// data whenever the event has been received.
+func NewAutoLogin(state AutoLoginState, publisher Publisher) *AutoLogin {
+	return &AutoLogin{state: state, publisher: publisher}
 }
 
 // Event returns the event name.
@@ -50,5 +52,10 @@ func (al *AutoLogin) Callback(ch *shadiaosocketio.Channel) error {
 		return err
 	}
 
+	// notify about the auto login
+	if al.publisher != nil {
+		al.publisher.Publish(AutoLoginEvent, nil)
+	}
+
 	return nil
 }
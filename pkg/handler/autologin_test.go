@@ -12,14 +12,14 @@ import (
 )
 
 func TestAutoLogin_Event(t *testing.T) {
-	c := handler.NewAutoLogin(nil)
+	c := handler.NewAutoLogin(nil, nil)
 
 	assert.Equal(t, handler.AutoLoginEvent, c.Event())
 }
 
 func TestAutoLogin_Register(t *testing.T) {
 	r := mocks.NewHandlerRegistrator(t)
-	c := handler.NewAutoLogin(nil)
+	c := handler.NewAutoLogin(nil, nil)
 
 	r.EXPECT().On(handler.AutoLoginEvent, mock.MatchedBy(func(any) bool {
 		return true
@@ -30,7 +30,7 @@ func TestAutoLogin_Register(t *testing.T) {
 
 func TestAutoLogin_Occurred(t *testing.T) {
 	s := mocks.NewAutoLoginState(t)
-	c := handler.NewAutoLogin(s)
+	c := handler.NewAutoLogin(s, nil)
 
 	s.EXPECT().HasSeen(handler.AutoLoginEvent).Return(false).Once()
 	s.EXPECT().HasSeen(handler.AutoLoginEvent).Return(true).Once()
@@ -41,7 +41,8 @@ func TestAutoLogin_Occurred(t *testing.T) {
 
 func TestAutoLogin_Callback(t *testing.T) {
 	type fields struct {
-		state *mocks.AutoLoginState
+		state     *mocks.AutoLoginState
+		publisher *mocks.Publisher
 	}
 
 	type args struct {
@@ -74,6 +75,26 @@ func TestAutoLogin_Callback(t *testing.T) {
 				f.state.AssertExpectations(t)
 			},
 		},
+		{
+			name: "publish",
+			fields: &fields{
+				state:     mocks.NewAutoLoginState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch: new(shadiaosocketio.Channel),
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.AutoLoginEvent).Return()
+				f.state.EXPECT().SetAutoLogin(true).Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.AutoLoginEvent, nil).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
 		{
 			name: "error",
 			fields: &fields{
@@ -96,7 +117,12 @@ func TestAutoLogin_Callback(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// setup mocks
-			c := handler.NewAutoLogin(tt.fields.state)
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewAutoLogin(tt.fields.state, publisher)
 
 			if tt.on != nil {
 				tt.on(tt.fields)
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+const (
+	AvgPingEvent = "avgPing"
+)
+
+type AvgPingState interface {
+	SetAvgPing(monitorId int, avgPing float64) (err error)
+	HasSeen(event string) (seen bool)
+	MarkSeen(event string)
+}
+
+// AvgPingData is the data published for avgPing events.
+type AvgPingData struct {
+	MonitorId int
+	AvgPing   float64
+}
+
+type AvgPing struct {
+	state     AvgPingState
+	publisher Publisher
+}
+
+// NewAvgPing creates a new AvgPing handler. The publisher is optional and receives every processed
+// average ping as AvgPingData.
+func NewAvgPing(state AvgPingState, publisher Publisher) *AvgPing {
+	return &AvgPing{state: state, publisher: publisher}
+}
+
+func (ap AvgPing) Event() string {
+	return AvgPingEvent
+}
+
+func (ap AvgPing) Register(h HandlerRegistrator) error {
+	return h.On(AvgPingEvent, ap.Callback)
+}
+
+func (ap AvgPing) Occurred() bool {
+	return ap.state.HasSeen(AvgPingEvent)
+}
+
+func (ap AvgPing) Callback(ch *shadiaosocketio.Channel, id, avgPing any) error {
+	ap.state.MarkSeen(AvgPingEvent)
+
+	// decode monitorId and average ping, the average ping is null for monitors without heartbeats
+	data := &AvgPingData{}
+	if err := utils.Decode(map[string]any{"monitorId": id, "avgPing": avgPing}, data); err != nil {
+		return fmt.Errorf("decode failed: %w", err)
+	}
+
+	// set average ping
+	if err := ap.state.SetAvgPing(data.MonitorId, data.AvgPing); err != nil {
+		return err
+	}
+
+	// publish average ping
+	if ap.publisher != nil {
+		ap.publisher.Publish(AvgPingEvent, *data)
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAvgPing_Event(t *testing.T) {
+	c := handler.NewAvgPing(nil, nil)
+
+	assert.Equal(t, handler.AvgPingEvent, c.Event())
+}
+
+func TestAvgPing_Register(t *testing.T) {
+	r := mocks.NewHandlerRegistrator(t)
+	c := handler.NewAvgPing(nil, nil)
+
+	r.EXPECT().On(handler.AvgPingEvent, mock.MatchedBy(func(any) bool {
+		return true
+	})).Return(nil).Once()
+
+	assert.NoError(t, c.Register(r))
+}
+
+func TestAvgPing_Occurred(t *testing.T) {
+	s := mocks.NewAvgPingState(t)
+	c := handler.NewAvgPing(s, nil)
+
+	s.EXPECT().HasSeen(handler.AvgPingEvent).Return(false).Once()
+	s.EXPECT().HasSeen(handler.AvgPingEvent).Return(true).Once()
+
+	assert.False(t, c.Occurred())
+	assert.True(t, c.Occurred())
+}
+
+func TestAvgPing_Callback(t *testing.T) {
+	type fields struct {
+		state     *mocks.AvgPingState
+		publisher *mocks.Publisher
+	}
+
+	type args struct {
+		ch      *shadiaosocketio.Channel
+		id      any
+		avgPing any
+	}
+
+	tests := []struct {
+		name   string
+		fields *fields
+		args   *args
+		want   *string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "ok",
+			fields: &fields{
+				state: mocks.NewAvgPingState(t),
+			},
+			args: &args{
+				ch:      &shadiaosocketio.Channel{},
+				id:      1.0,
+				avgPing: 42.5,
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.AvgPingEvent).Return().Once()
+				f.state.EXPECT().SetAvgPing(1, 42.5).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "null",
+			fields: &fields{
+				state: mocks.NewAvgPingState(t),
+			},
+			args: &args{
+				ch:      &shadiaosocketio.Channel{},
+				id:      1.0,
+				avgPing: nil,
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.AvgPingEvent).Return().Once()
+				f.state.EXPECT().SetAvgPing(1, 0.0).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "publish",
+			fields: &fields{
+				state:     mocks.NewAvgPingState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch:      &shadiaosocketio.Channel{},
+				id:      2.0,
+				avgPing: 12.0,
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.AvgPingEvent).Return().Once()
+				f.state.EXPECT().SetAvgPing(2, 12.0).Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.AvgPingEvent, handler.AvgPingData{MonitorId: 2, AvgPing: 12}).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
+		{
+			name: "decode failed",
+			fields: &fields{
+				state: mocks.NewAvgPingState(t),
+			},
+			args: &args{
+				ch:      &shadiaosocketio.Channel{},
+				id:      1.0,
+				avgPing: "fast",
+			},
+			want: utils.NewString("decode failed"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.AvgPingEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetAvgPing", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "set average ping failed",
+			fields: &fields{
+				state: mocks.NewAvgPingState(t),
+			},
+			args: &args{
+				ch:      &shadiaosocketio.Channel{},
+				id:      1.0,
+				avgPing: 1.0,
+			},
+			want: utils.NewString(state.ErrStateNil.Error()),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.AvgPingEvent).Return().Once()
+				f.state.EXPECT().SetAvgPing(1, 1.0).Return(state.ErrStateNil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// setup mocks
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewAvgPing(tt.fields.state, publisher)
+
+			if tt.on != nil {
+				tt.on(tt.fields)
+			}
+
+			// run function
+			got := c.Callback(tt.args.ch, tt.args.id, tt.args.avgPing)
+
+			// assert results
+			if tt.want != nil && assert.NotNil(t, got) {
+				assert.ErrorContains(t, got, *tt.want)
+			}
+
+			if tt.want == nil {
+				assert.NoError(t, got)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, tt.fields)
+			}
+		})
+	}
+}
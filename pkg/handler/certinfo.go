@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+const (
+	CertInfoEvent = "certInfo"
+)
+
+type CertInfoState interface {
+	SetCertInfo(monitorId int, certInfo *state.CertInfo) (err error)
+	HasSeen(event string) (seen bool)
+	MarkSeen(event string)
+}
+
+// CertInfoData is the data published for certInfo events.
+type CertInfoData struct {
+	MonitorId int
+	CertInfo  state.CertInfo
+}
+
+type CertInfo struct {
+	state     CertInfoState
+	publisher Publisher
+}
+
+// NewCertInfo creates a new CertInfo handler. The publisher is optional and receives every
+// processed TLS information as CertInfoData.
+func NewCertInfo(state CertInfoState, publisher Publisher) *CertInfo {
+	return &CertInfo{state: state, publisher: publisher}
+}
+
+func (ci CertInfo) Event() string {
+	return CertInfoEvent
+}
+
+func (ci CertInfo) Register(h HandlerRegistrator) error {
+	return h.On(CertInfoEvent, ci.Callback)
+}
+
+func (ci CertInfo) Occurred() bool {
+	return ci.state.HasSeen(CertInfoEvent)
+}
+
+func (ci CertInfo) Callback(ch *shadiaosocketio.Channel, id, info any) error {
+	ci.state.MarkSeen(CertInfoEvent)
+
+	// assert data type, the TLS information is sent as JSON string
+	typedData, ok := info.(string)
+	if !ok {
+		return NewErrInvalidDataType("string", info)
+	}
+
+	var rawInfo any
+	if err := json.Unmarshal([]byte(typedData), &rawInfo); err != nil {
+		return fmt.Errorf("decode failed: %w", err)
+	}
+
+	// decode monitorId and TLS information
+	data := &struct {
+		MonitorId int            `mapstructure:"monitorId"`
+		CertInfo  state.CertInfo `mapstructure:"certInfo"`
+	}{}
+	if err := utils.Decode(map[string]any{"monitorId": id, "certInfo": rawInfo}, data); err != nil {
+		return fmt.Errorf("decode failed: %w", err)
+	}
+
+	// set TLS information
+	if err := ci.state.SetCertInfo(data.MonitorId, &data.CertInfo); err != nil {
+		return err
+	}
+
+	// publish TLS information
+	if ci.publisher != nil {
+		ci.publisher.Publish(CertInfoEvent, CertInfoData{MonitorId: data.MonitorId, CertInfo: data.CertInfo})
+	}
+
+	return nil
+}
@@ -0,0 +1,260 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCertInfo_Event(t *testing.T) {
+	c := handler.NewCertInfo(nil, nil)
+
+	assert.Equal(t, handler.CertInfoEvent, c.Event())
+}
+
+func TestCertInfo_Register(t *testing.T) {
+	r := mocks.NewHandlerRegistrator(t)
+	c := handler.NewCertInfo(nil, nil)
+
+	r.EXPECT().On(handler.CertInfoEvent, mock.MatchedBy(func(any) bool {
+		return true
+	})).Return(nil).Once()
+
+	assert.NoError(t, c.Register(r))
+}
+
+func TestCertInfo_Occurred(t *testing.T) {
+	s := mocks.NewCertInfoState(t)
+	c := handler.NewCertInfo(s, nil)
+
+	s.EXPECT().HasSeen(handler.CertInfoEvent).Return(false).Once()
+	s.EXPECT().HasSeen(handler.CertInfoEvent).Return(true).Once()
+
+	assert.False(t, c.Occurred())
+	assert.True(t, c.Occurred())
+}
+
+func TestCertInfo_Callback(t *testing.T) {
+	type fields struct {
+		state     *mocks.CertInfoState
+		publisher *mocks.Publisher
+	}
+
+	type args struct {
+		ch   *shadiaosocketio.Channel
+		id   any
+		info any
+	}
+
+	info := `{
+		"valid": true,
+		"certInfo": {
+			"subject": {"CN": "example.com"},
+			"issuer": {"CN": "R3"},
+			"subjectaltname": "DNS:example.com",
+			"validFor": ["example.com"],
+			"serialNumber": "01",
+			"fingerprint": "AA:BB",
+			"fingerprint256": "AA:BB:CC",
+			"valid_from": "Jan  1 00:00:00 2024 GMT",
+			"valid_to": "Apr  1 00:00:00 2024 GMT",
+			"validTo": "2024-04-01T00:00:00.000Z",
+			"daysRemaining": 30,
+			"certType": "server",
+			"issuerCertificate": {
+				"subject": {"CN": "R3"},
+				"validTo": "2025-09-15T16:00:00.000Z",
+				"daysRemaining": 560,
+				"certType": "intermediate CA"
+			}
+		}
+	}`
+
+	want := &state.CertInfo{
+		Valid: true,
+		Certificate: &state.Certificate{
+			Subject:        map[string]any{"CN": "example.com"},
+			Issuer:         map[string]any{"CN": "R3"},
+			SubjectAltName: "DNS:example.com",
+			ValidFor:       []string{"example.com"},
+			SerialNumber:   "01",
+			Fingerprint:    "AA:BB",
+			Fingerprint256: "AA:BB:CC",
+			ValidFrom:      "Jan  1 00:00:00 2024 GMT",
+			ValidTo:        time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			DaysRemaining:  30,
+			CertType:       "server",
+			IssuerCertificate: &state.Certificate{
+				Subject:       map[string]any{"CN": "R3"},
+				ValidTo:       time.Date(2025, 9, 15, 16, 0, 0, 0, time.UTC),
+				DaysRemaining: 560,
+				CertType:      "intermediate CA",
+			},
+			Unmapped: map[string]any{"valid_to": "Apr  1 00:00:00 2024 GMT"},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		fields *fields
+		args   *args
+		want   *string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "ok",
+			fields: &fields{
+				state: mocks.NewCertInfoState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				id:   1.0,
+				info: info,
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.CertInfoEvent).Return().Once()
+				f.state.EXPECT().SetCertInfo(1, want).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "publish",
+			fields: &fields{
+				state:     mocks.NewCertInfoState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				id:   2.0,
+				info: `{"valid": false}`,
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.CertInfoEvent).Return().Once()
+				f.state.EXPECT().SetCertInfo(2, &state.CertInfo{}).Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.CertInfoEvent, handler.CertInfoData{MonitorId: 2}).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
+		{
+			name: "invalid data",
+			fields: &fields{
+				state: mocks.NewCertInfoState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				id:   1.0,
+				info: map[string]any{"valid": true},
+			},
+			want: utils.NewString("invalid data type"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.CertInfoEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetCertInfo", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "invalid json",
+			fields: &fields{
+				state: mocks.NewCertInfoState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				id:   1.0,
+				info: "{",
+			},
+			want: utils.NewString("decode failed"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.CertInfoEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetCertInfo", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "decode failed",
+			fields: &fields{
+				state: mocks.NewCertInfoState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				id:   1.0,
+				info: `{"certInfo": {"validTo": "tomorrow"}}`,
+			},
+			want: utils.NewString("decode failed"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.CertInfoEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetCertInfo", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "set cert info failed",
+			fields: &fields{
+				state: mocks.NewCertInfoState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				id:   1.0,
+				info: `{"valid": false}`,
+			},
+			want: utils.NewString(state.ErrStateNil.Error()),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.CertInfoEvent).Return().Once()
+				f.state.EXPECT().SetCertInfo(1, &state.CertInfo{}).Return(state.ErrStateNil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// setup mocks
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewCertInfo(tt.fields.state, publisher)
+
+			if tt.on != nil {
+				tt.on(tt.fields)
+			}
+
+			// run function
+			got := c.Callback(tt.args.ch, tt.args.id, tt.args.info)
+
+			// assert results
+			if tt.want != nil && assert.NotNil(t, got) {
+				assert.ErrorContains(t, got, *tt.want)
+			}
+
+			if tt.want == nil {
+				assert.NoError(t, got)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, tt.fields)
+			}
+		})
+	}
+}
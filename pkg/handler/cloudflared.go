@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+const (
+	CloudflaredInstalledEvent    = "cloudflared_installed"
+	CloudflaredRunningEvent      = "cloudflared_running"
+	CloudflaredMessageEvent      = "cloudflared_message"
+	CloudflaredErrorMessageEvent = "cloudflared_errorMessage"
+	CloudflaredTokenEvent        = "cloudflared_token"
+)
+
+// CloudflaredEvents lists the status events of the Cloudflare Tunnel, each handled by a Cloudflared
+// handler.
+var CloudflaredEvents = []string{
+	CloudflaredInstalledEvent,
+	CloudflaredRunningEvent,
+	CloudflaredMessageEvent,
+	CloudflaredErrorMessageEvent,
+	CloudflaredTokenEvent,
+}
+
+type CloudflaredState interface {
+	SetCloudflaredInstalled(installed bool) (err error)
+	SetCloudflaredRunning(running bool) (err error)
+	SetCloudflaredMessage(msg string) (err error)
+	SetCloudflaredErrorMessage(msg string) (err error)
+	SetCloudflaredToken(token string) (err error)
+	HasSeen(event string) (seen bool)
+	MarkSeen(event string)
+}
+
+// Cloudflared handles one of the status events of the Cloudflare Tunnel, see CloudflaredEvents.
+type Cloudflared struct {
+	event     string
+	state     CloudflaredState
+	publisher Publisher
+}
+
+// NewCloudflared creates a new Cloudflared handler for the given event. The publisher is optional
+// and receives the processed value, a bool for the installed and running events and a string for
+// all others.
+func NewCloudflared(event string, state CloudflaredState, publisher Publisher) *Cloudflared {
+	return &Cloudflared{event: event, state: state, publisher: publisher}
+}
+
+func (cf *Cloudflared) Event() string {
+	return cf.event
+}
+
+func (cf *Cloudflared) Register(h HandlerRegistrator) error {
+	return h.On(cf.event, cf.Callback)
+}
+
+func (cf *Cloudflared) Occurred() bool {
+	return cf.state.HasSeen(cf.event)
+}
+
+func (cf *Cloudflared) Callback(ch *shadiaosocketio.Channel, data any) error {
+	cf.state.MarkSeen(cf.event)
+
+	var (
+		value any
+		err   error
+	)
+
+	switch cf.event {
+	case CloudflaredInstalledEvent, CloudflaredRunningEvent:
+		value, err = cf.setBool(data)
+	case CloudflaredMessageEvent, CloudflaredErrorMessageEvent, CloudflaredTokenEvent:
+		value, err = cf.setString(data)
+	default:
+		return fmt.Errorf("unknown cloudflared event %q", cf.event)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// publish value
+	if cf.publisher != nil {
+		cf.publisher.Publish(cf.event, value)
+	}
+
+	return nil
+}
+
+// setBool decodes the data as bool and stores it.
+func (cf *Cloudflared) setBool(data any) (bool, error) {
+	decoded := &struct{ Value bool }{}
+	if err := utils.Decode(map[string]any{"value": data}, decoded); err != nil {
+		return false, fmt.Errorf("decode failed: %w", err)
+	}
+
+	set := cf.state.SetCloudflaredInstalled
+	if cf.event == CloudflaredRunningEvent {
+		set = cf.state.SetCloudflaredRunning
+	}
+
+	return decoded.Value, set(decoded.Value)
+}
+
+// setString decodes the data as string and stores it, null is stored as empty string.
+func (cf *Cloudflared) setString(data any) (string, error) {
+	decoded := &struct{ Value string }{}
+	if err := utils.Decode(map[string]any{"value": data}, decoded); err != nil {
+		return "", fmt.Errorf("decode failed: %w", err)
+	}
+
+	set := cf.state.SetCloudflaredMessage
+	switch cf.event {
+	case CloudflaredErrorMessageEvent:
+		set = cf.state.SetCloudflaredErrorMessage
+	case CloudflaredTokenEvent:
+		set = cf.state.SetCloudflaredToken
+	}
+
+	return decoded.Value, set(decoded.Value)
+}
@@ -0,0 +1,250 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCloudflared_Event(t *testing.T) {
+	c := handler.NewCloudflared(handler.CloudflaredRunningEvent, nil, nil)
+
+	assert.Equal(t, handler.CloudflaredRunningEvent, c.Event())
+}
+
+func TestCloudflared_Register(t *testing.T) {
+	r := mocks.NewHandlerRegistrator(t)
+	c := handler.NewCloudflared(handler.CloudflaredRunningEvent, nil, nil)
+
+	r.EXPECT().On(handler.CloudflaredRunningEvent, mock.MatchedBy(func(any) bool {
+		return true
+	})).Return(nil).Once()
+
+	assert.NoError(t, c.Register(r))
+}
+
+func TestCloudflared_Occurred(t *testing.T) {
+	s := mocks.NewCloudflaredState(t)
+	c := handler.NewCloudflared(handler.CloudflaredTokenEvent, s, nil)
+
+	s.EXPECT().HasSeen(handler.CloudflaredTokenEvent).Return(false).Once()
+	s.EXPECT().HasSeen(handler.CloudflaredTokenEvent).Return(true).Once()
+
+	assert.False(t, c.Occurred())
+	assert.True(t, c.Occurred())
+}
+
+func TestCloudflared_Callback(t *testing.T) {
+	type fields struct {
+		state     *mocks.CloudflaredState
+		publisher *mocks.Publisher
+	}
+
+	type args struct {
+		ch   *shadiaosocketio.Channel
+		data any
+	}
+
+	tests := []struct {
+		name   string
+		event  string
+		fields *fields
+		args   *args
+		want   *string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name:  "installed",
+			event: handler.CloudflaredInstalledEvent,
+			fields: &fields{
+				state: mocks.NewCloudflaredState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: true,
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.CloudflaredInstalledEvent).Return().Once()
+				f.state.EXPECT().SetCloudflaredInstalled(true).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name:  "running",
+			event: handler.CloudflaredRunningEvent,
+			fields: &fields{
+				state:     mocks.NewCloudflaredState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: false,
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.CloudflaredRunningEvent).Return().Once()
+				f.state.EXPECT().SetCloudflaredRunning(false).Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.CloudflaredRunningEvent, false).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
+		{
+			name:  "message",
+			event: handler.CloudflaredMessageEvent,
+			fields: &fields{
+				state: mocks.NewCloudflaredState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: "Connection registered",
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.CloudflaredMessageEvent).Return().Once()
+				f.state.EXPECT().SetCloudflaredMessage("Connection registered").Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name:  "error message",
+			event: handler.CloudflaredErrorMessageEvent,
+			fields: &fields{
+				state: mocks.NewCloudflaredState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: "invalid token",
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.CloudflaredErrorMessageEvent).Return().Once()
+				f.state.EXPECT().SetCloudflaredErrorMessage("invalid token").Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name:  "null token",
+			event: handler.CloudflaredTokenEvent,
+			fields: &fields{
+				state:     mocks.NewCloudflaredState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: nil,
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.CloudflaredTokenEvent).Return().Once()
+				f.state.EXPECT().SetCloudflaredToken("").Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.CloudflaredTokenEvent, "").Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
+		{
+			name:  "decode failed",
+			event: handler.CloudflaredRunningEvent,
+			fields: &fields{
+				state: mocks.NewCloudflaredState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{true},
+			},
+			want: utils.NewString("decode failed"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.CloudflaredRunningEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetCloudflaredRunning", mock.Anything)
+			},
+		},
+		{
+			name:  "unknown event",
+			event: "cloudflared_unknown",
+			fields: &fields{
+				state: mocks.NewCloudflaredState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: true,
+			},
+			want: utils.NewString(`unknown cloudflared event "cloudflared_unknown"`),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen("cloudflared_unknown").Return().Once()
+			},
+		},
+		{
+			name:  "set token failed",
+			event: handler.CloudflaredTokenEvent,
+			fields: &fields{
+				state: mocks.NewCloudflaredState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: "token",
+			},
+			want: utils.NewString(state.ErrStateNil.Error()),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.CloudflaredTokenEvent).Return().Once()
+				f.state.EXPECT().SetCloudflaredToken("token").Return(state.ErrStateNil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// setup mocks
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewCloudflared(tt.event, tt.fields.state, publisher)
+
+			if tt.on != nil {
+				tt.on(tt.fields)
+			}
+
+			// run function
+			got := c.Callback(tt.args.ch, tt.args.data)
+
+			// assert results
+			if tt.want != nil && assert.NotNil(t, got) {
+				assert.ErrorContains(t, got, *tt.want)
+			}
+
+			if tt.want == nil {
+				assert.NoError(t, got)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, tt.fields)
+			}
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"log/slog"
 
 	"github.com/Baiguoshuai1/shadiaosocketio"
-	"github.com/nobbs/uptime-kuma-api/pkg/state"
 )
 
 const (
@@ -19,12 +18,14 @@ type ConnectState interface {
 
 // Connect is a handler for the connect event.
 type Connect struct {
-	state ConnectState
+	state     ConnectState
+	publisher Publisher
 }
 
-// NewConnect returns a new Connect handler.
-func NewConnect(state ConnectState) *Connect {
-	return &Connect{state: state}
+// NewConnect returns a new Connect handler. The publisher is optional and is notified without data
+// whenever the connection has been established.
+func NewConnect(state ConnectState, publisher Publisher) *Connect {
+	return &Connect{state: state, publisher: publisher}
 }
 
 // Event returns the event name.
@@ -37,10 +38,11 @@ func (c *Connect) Register(h HandlerRegistrator) error {
 	return h.On(ConnectEvent, c.Callback)
 }
 
-// Occurred returns true if the event has occurred at least once.
+// Occurred returns true if the client is currently connected, i.e. the event has occurred and no
+// disconnect has happened since.
 func (c *Connect) Occurred() bool {
-	_, err := c.state.Connected()
-	return !errors.Is(err, state.ErrNotSetYet)
+	connected, err := c.state.Connected()
+	return err == nil && connected
 }
 
 // Callback handles the event.
@@ -55,5 +57,10 @@ func (c *Connect) Callback(ch *shadiaosocketio.Channel) error {
 		return err
 	}
 
+	// notify about the connection
+	if c.publisher != nil {
+		c.publisher.Publish(ConnectEvent, nil)
+	}
+
 	return nil
 }
@@ -13,14 +13,14 @@ import (
 )
 
 func TestConnect_Event(t *testing.T) {
-	c := handler.NewConnect(nil)
+	c := handler.NewConnect(nil, nil)
 
 	assert.Equal(t, handler.ConnectEvent, c.Event())
 }
 
 func TestConnect_Register(t *testing.T) {
 	r := mocks.NewHandlerRegistrator(t)
-	c := handler.NewConnect(nil)
+	c := handler.NewConnect(nil, nil)
 
 	r.EXPECT().On(handler.ConnectEvent, mock.MatchedBy(func(any) bool {
 		return true
@@ -31,18 +31,21 @@ func TestConnect_Register(t *testing.T) {
 
 func TestConnect_Occurred(t *testing.T) {
 	s := mocks.NewConnectState(t)
-	c := handler.NewConnect(s)
+	c := handler.NewConnect(s, nil)
 
 	s.EXPECT().Connected().Return(false, state.ErrNotSetYet).Once()
 	s.EXPECT().Connected().Return(true, nil).Once()
+	s.EXPECT().Connected().Return(false, nil).Once()
 
 	assert.False(t, c.Occurred())
 	assert.True(t, c.Occurred())
+	assert.False(t, c.Occurred())
 }
 
 func TestConnect_Callback(t *testing.T) {
 	type fields struct {
-		state *mocks.ConnectState
+		state     *mocks.ConnectState
+		publisher *mocks.Publisher
 	}
 
 	type args struct {
@@ -74,6 +77,25 @@ func TestConnect_Callback(t *testing.T) {
 				f.state.AssertExpectations(t)
 			},
 		},
+		{
+			name: "publish",
+			fields: &fields{
+				state:     mocks.NewConnectState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch: new(shadiaosocketio.Channel),
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().SetConnected(true).Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.ConnectEvent, nil).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
 		{
 			name: "nil channel",
 			fields: &fields{
@@ -109,7 +131,12 @@ func TestConnect_Callback(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// setup mocks
-			c := handler.NewConnect(tt.fields.state)
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewConnect(tt.fields.state, publisher)
 
 			if tt.on != nil {
 				tt.on(tt.fields)
@@ -13,16 +13,31 @@ const (
 
 type DisconnectState interface {
 	SetConnected(bool) error
+	HasSeen(event string) (seen bool)
+	MarkSeen(event string)
+}
+
+// DisconnectReason is the data published for disconnect events.
+type DisconnectReason = websocket.CloseError
+
+// Reconnector is the interface that wraps the Reconnect method. Reconnect is called after the
+// connection to the server has been lost and must not block.
+type Reconnector interface {
+	Reconnect()
 }
 
 // Disconnect is a handler for the disconnect event.
 type Disconnect struct {
-	state DisconnectState
+	state       DisconnectState
+	reconnector Reconnector
+	publisher   Publisher
 }
 
-// NewDisconnect returns a new Disconnect handler.
-func NewDisconnect(state DisconnectState) *Disconnect {
-	return &Disconnect{state: state}
+// NewDisconnect returns a new Disconnect handler. The reconnector is optional and is notified
+// whenever the connection has been lost. The publisher is optional and receives the
+// DisconnectReason of every disconnect.
+func NewDisconnect(state DisconnectState, reconnector Reconnector, publisher Publisher) *Disconnect {
+	return &Disconnect{state: state, reconnector: reconnector, publisher: publisher}
 }
 
 // Event returns the event name.
@@ -37,8 +52,7 @@ func (d *Disconnect) Register(h HandlerRegistrator) error {
 
 // Occurred returns true if the event has occurred at least once.
 func (d *Disconnect) Occurred() bool {
-	// TODO: implement either reconnect or exit logic
-	return false
+	return d.state.HasSeen(DisconnectEvent)
 }
 
 // Callback handles the event.
@@ -49,9 +63,21 @@ func (d *Disconnect) Callback(ch *shadiaosocketio.Channel, reason websocket.Clos
 		return nil
 	}
 
+	d.state.MarkSeen(DisconnectEvent)
+
 	if err := d.state.SetConnected(false); err != nil {
 		return err
 	}
 
+	// publish reason
+	if d.publisher != nil {
+		d.publisher.Publish(DisconnectEvent, reason)
+	}
+
+	// hand over to the reconnector, if any
+	if d.reconnector != nil {
+		d.reconnector.Reconnect()
+	}
+
 	return nil
 }
@@ -13,14 +13,14 @@ import (
 )
 
 func TestDisconnect_Event(t *testing.T) {
-	c := handler.NewDisconnect(nil)
+	c := handler.NewDisconnect(nil, nil, nil)
 
 	assert.Equal(t, handler.DisconnectEvent, c.Event())
 }
 
 func TestDisconnect_Register(t *testing.T) {
 	r := mocks.NewHandlerRegistrator(t)
-	c := handler.NewDisconnect(nil)
+	c := handler.NewDisconnect(nil, nil, nil)
 
 	r.EXPECT().On(handler.DisconnectEvent, mock.MatchedBy(func(any) bool {
 		return true
@@ -31,14 +31,20 @@ func TestDisconnect_Register(t *testing.T) {
 
 func TestDisconnect_Occurred(t *testing.T) {
 	s := mocks.NewDisconnectState(t)
-	c := handler.NewDisconnect(s)
+	c := handler.NewDisconnect(s, nil, nil)
+
+	s.EXPECT().HasSeen(handler.DisconnectEvent).Return(false).Once()
+	s.EXPECT().HasSeen(handler.DisconnectEvent).Return(true).Once()
 
 	assert.False(t, c.Occurred())
+	assert.True(t, c.Occurred())
 }
 
 func TestDisconnect_Callback(t *testing.T) {
 	type fields struct {
-		state *mocks.DisconnectState
+		state       *mocks.DisconnectState
+		reconnector *mocks.Reconnector
+		publisher   *mocks.Publisher
 	}
 
 	type args struct {
@@ -66,10 +72,53 @@ func TestDisconnect_Callback(t *testing.T) {
 			},
 			want: nil,
 			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.DisconnectEvent).Return().Once()
+				f.state.EXPECT().SetConnected(false).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "reconnect",
+			fields: &fields{
+				state:       mocks.NewDisconnectState(t),
+				reconnector: mocks.NewReconnector(t),
+			},
+			args: &args{
+				ch:     new(shadiaosocketio.Channel),
+				reason: websocket.CloseError{},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.DisconnectEvent).Return().Once()
 				f.state.EXPECT().SetConnected(false).Return(nil).Once()
+				f.reconnector.EXPECT().Reconnect().Return().Once()
 			},
 			assert: func(t *testing.T, f *fields) {
 				f.state.AssertExpectations(t)
+				f.reconnector.AssertExpectations(t)
+			},
+		},
+		{
+			name: "publish",
+			fields: &fields{
+				state:     mocks.NewDisconnectState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch:     new(shadiaosocketio.Channel),
+				reason: websocket.CloseError{},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.DisconnectEvent).Return().Once()
+				f.state.EXPECT().SetConnected(false).Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.DisconnectEvent, handler.DisconnectReason{}).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
 			},
 		},
 		{
@@ -98,6 +147,7 @@ func TestDisconnect_Callback(t *testing.T) {
 			},
 			want: state.ErrStateNil,
 			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.DisconnectEvent).Return().Once()
 				f.state.EXPECT().SetConnected(false).Return(state.ErrStateNil).Once()
 			},
 			assert: func(t *testing.T, f *fields) {
@@ -109,7 +159,17 @@ func TestDisconnect_Callback(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// setup mocks
-			c := handler.NewDisconnect(tt.fields.state)
+			var reconnector handler.Reconnector
+			if tt.fields.reconnector != nil {
+				reconnector = tt.fields.reconnector
+			}
+
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewDisconnect(tt.fields.state, reconnector, publisher)
 
 			if tt.on != nil {
 				tt.on(tt.fields)
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+const (
+	DockerHostListEvent = "dockerHostList"
+)
+
+type DockerHostState interface {
+	SetDockerHosts(dockerHosts []state.DockerHost) (err error)
+	HasSeen(event string) (seen bool)
+	MarkSeen(event string)
+}
+
+type DockerHostList struct {
+	state     DockerHostState
+	publisher Publisher
+}
+
+// NewDockerHostList creates a new DockerHostList handler. The publisher is optional and receives the
+// processed docker hosts as []state.DockerHost.
+func NewDockerHostList(state DockerHostState, publisher Publisher) *DockerHostList {
+	return &DockerHostList{state: state, publisher: publisher}
+}
+
+func (dl DockerHostList) Event() string {
+	return DockerHostListEvent
+}
+
+func (dl DockerHostList) Register(h HandlerRegistrator) error {
+	return h.On(DockerHostListEvent, dl.Callback)
+}
+
+func (dl DockerHostList) Occurred() bool {
+	return dl.state.HasSeen(DockerHostListEvent)
+}
+
+func (dl DockerHostList) Callback(ch *shadiaosocketio.Channel, data any) error {
+	dl.state.MarkSeen(DockerHostListEvent)
+
+	// assert data type
+	typedData, ok := data.([]any)
+	if !ok {
+		return NewErrInvalidDataType("[]any", data)
+	}
+
+	// decode data into slice of docker hosts
+	dockerHosts := make([]state.DockerHost, 0, len(typedData))
+
+	dockerHosts, err := utils.DecodeSlice(typedData, dockerHosts)
+	if err != nil {
+		return fmt.Errorf("decode failed: %w", err)
+	}
+
+	// set docker hosts
+	if err := dl.state.SetDockerHosts(dockerHosts); err != nil {
+		return err
+	}
+
+	// publish docker hosts
+	if dl.publisher != nil {
+		dl.publisher.Publish(DockerHostListEvent, dockerHosts)
+	}
+
+	return nil
+}
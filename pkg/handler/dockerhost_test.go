@@ -0,0 +1,225 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDockerHostList_Event(t *testing.T) {
+	c := handler.NewDockerHostList(nil, nil)
+
+	assert.Equal(t, handler.DockerHostListEvent, c.Event())
+}
+
+func TestDockerHostList_Register(t *testing.T) {
+	r := mocks.NewHandlerRegistrator(t)
+	c := handler.NewDockerHostList(nil, nil)
+
+	r.EXPECT().On(handler.DockerHostListEvent, mock.MatchedBy(func(any) bool {
+		return true
+	})).Return(nil).Once()
+
+	assert.NoError(t, c.Register(r))
+}
+
+func TestDockerHostList_Occurred(t *testing.T) {
+	s := mocks.NewDockerHostState(t)
+	c := handler.NewDockerHostList(s, nil)
+
+	s.EXPECT().HasSeen(handler.DockerHostListEvent).Return(false).Once()
+	s.EXPECT().HasSeen(handler.DockerHostListEvent).Return(true).Once()
+
+	assert.False(t, c.Occurred())
+	assert.True(t, c.Occurred())
+}
+
+func TestDockerHostList_Callback(t *testing.T) {
+	type fields struct {
+		state     *mocks.DockerHostState
+		publisher *mocks.Publisher
+	}
+
+	type args struct {
+		ch   *shadiaosocketio.Channel
+		data any
+	}
+
+	dockerHost := map[string]any{
+		"id":           1,
+		"userID":       1,
+		"name":         "local",
+		"dockerDaemon": "/var/run/docker.sock",
+		"dockerType":   "socket",
+	}
+
+	want := []state.DockerHost{
+		{
+			Id:           1,
+			UserId:       1,
+			Name:         "local",
+			DockerDaemon: "/var/run/docker.sock",
+			DockerType:   state.DockerHostTypeSocket,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		fields *fields
+		args   *args
+		want   *string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "ok",
+			fields: &fields{
+				state: mocks.NewDockerHostState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{dockerHost},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.DockerHostListEvent).Return().Once()
+				f.state.EXPECT().SetDockerHosts(want).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "empty",
+			fields: &fields{
+				state: mocks.NewDockerHostState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.DockerHostListEvent).Return().Once()
+				f.state.EXPECT().SetDockerHosts([]state.DockerHost{}).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "publish",
+			fields: &fields{
+				state:     mocks.NewDockerHostState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{dockerHost},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.DockerHostListEvent).Return().Once()
+				f.state.EXPECT().SetDockerHosts(want).Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.DockerHostListEvent, want).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
+		{
+			name: "invalid data",
+			fields: &fields{
+				state: mocks.NewDockerHostState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: "invalid data",
+			},
+			want: utils.NewString("invalid data type"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.DockerHostListEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetDockerHosts", mock.Anything)
+			},
+		},
+		{
+			name: "decode failed",
+			fields: &fields{
+				state: mocks.NewDockerHostState(t),
+			},
+			args: &args{
+				ch: &shadiaosocketio.Channel{},
+				data: []any{
+					map[string]any{"id": "one"},
+				},
+			},
+			want: utils.NewString("decode failed"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.DockerHostListEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetDockerHosts", mock.Anything)
+			},
+		},
+		{
+			name: "set docker hosts failed",
+			fields: &fields{
+				state: mocks.NewDockerHostState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{},
+			},
+			want: utils.NewString(state.ErrStateNil.Error()),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.DockerHostListEvent).Return().Once()
+				f.state.EXPECT().SetDockerHosts([]state.DockerHost{}).Return(state.ErrStateNil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// setup mocks
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewDockerHostList(tt.fields.state, publisher)
+
+			if tt.on != nil {
+				tt.on(tt.fields)
+			}
+
+			// run function
+			got := c.Callback(tt.args.ch, tt.args.data)
+
+			// assert results
+			if tt.want != nil && assert.NotNil(t, got) {
+				assert.ErrorContains(t, got, *tt.want)
+			}
+
+			if tt.want == nil {
+				assert.NoError(t, got)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, tt.fields)
+			}
+		})
+	}
+}
@@ -12,11 +12,14 @@ const (
 )
 
 type Error struct {
-	state *state.State
+	state     *state.State
+	publisher Publisher
 }
 
-func NewError(state *state.State) *Error {
-	return &Error{state: state}
+// NewError creates a new Error handler. The publisher is optional and receives the data of every
+// error event.
+func NewError(state *state.State, publisher Publisher) *Error {
+	return &Error{state: state, publisher: publisher}
 }
 
 func (e *Error) Event() string {
@@ -34,5 +37,10 @@ func (e *Error) Occurred() bool {
 
 func (e *Error) Callback(ch *shadiaosocketio.Channel, data any) error {
 	slog.Warn("received error event", slog.Any("data", data))
+
+	if e.publisher != nil {
+		e.publisher.Publish(ErrorEvent, data)
+	}
+
 	return nil
 }
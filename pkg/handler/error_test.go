@@ -10,14 +10,14 @@ import (
 )
 
 func TestError_Event(t *testing.T) {
-	c := handler.NewError(nil)
+	c := handler.NewError(nil, nil)
 
 	assert.Equal(t, handler.ErrorEvent, c.Event())
 }
 
 func TestError_Register(t *testing.T) {
 	r := mocks.NewHandlerRegistrator(t)
-	c := handler.NewError(nil)
+	c := handler.NewError(nil, nil)
 
 	r.EXPECT().On(handler.ErrorEvent, mock.MatchedBy(func(any) bool {
 		return true
@@ -27,13 +27,22 @@ func TestError_Register(t *testing.T) {
 }
 
 func TestError_Occurred(t *testing.T) {
-	c := handler.NewError(nil)
+	c := handler.NewError(nil, nil)
 
 	assert.False(t, c.Occurred())
 }
 
 func TestError_Callback(t *testing.T) {
-	c := handler.NewError(nil)
+	c := handler.NewError(nil, nil)
 
 	assert.NoError(t, c.Callback(nil, nil))
 }
+
+func TestError_Callback_Publish(t *testing.T) {
+	p := mocks.NewPublisher(t)
+	c := handler.NewError(nil, p)
+
+	p.EXPECT().Publish(handler.ErrorEvent, "error").Return().Once()
+
+	assert.NoError(t, c.Callback(nil, "error"))
+}
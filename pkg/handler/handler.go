@@ -4,3 +4,9 @@ package handler
 type HandlerRegistrator interface {
 	On(string, any) error
 }
+
+// Publisher is an interface that defines the methods required to forward the data of processed
+// events, e.g. to subscribers of the client.
+type Publisher interface {
+	Publish(event string, data any)
+}
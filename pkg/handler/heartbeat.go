@@ -30,28 +30,44 @@ type ImportantHeartbeatListState interface {
 	SetImportantHeartbeats(monitorId int, beats []state.Heartbeat, overwrite bool) (err error)
 }
 
+// HeartbeatListData is the data published for heartbeat list and important heartbeat list events.
+type HeartbeatListData struct {
+	MonitorId  int
+	Heartbeats []state.Heartbeat
+	Overwrite  bool
+}
+
 type Heartbeat struct {
-	state HeartbeatState
+	state     HeartbeatState
+	publisher Publisher
 }
 
 type HeartbeatList struct {
-	state HeartbeatListState
+	state     HeartbeatListState
+	publisher Publisher
 }
 
 type ImportantHeartbeatList struct {
-	state ImportantHeartbeatListState
+	state     ImportantHeartbeatListState
+	publisher Publisher
 }
 
-func NewHeartbeat(state HeartbeatState) *Heartbeat {
-	return &Heartbeat{state: state}
+// NewHeartbeat creates a new Heartbeat handler. The publisher is optional and receives every
+// processed heartbeat as state.Heartbeat.
+func NewHeartbeat(state HeartbeatState, publisher Publisher) *Heartbeat {
+	return &Heartbeat{state: state, publisher: publisher}
 }
 
-func NewHeartbeatList(state HeartbeatListState) *HeartbeatList {
-	return &HeartbeatList{state: state}
+// NewHeartbeatList creates a new HeartbeatList handler. The publisher is optional and receives
+// every processed list as HeartbeatListData.
+func NewHeartbeatList(state HeartbeatListState, publisher Publisher) *HeartbeatList {
+	return &HeartbeatList{state: state, publisher: publisher}
 }
 
-func NewImportantHeartbeatList(state ImportantHeartbeatListState) *ImportantHeartbeatList {
-	return &ImportantHeartbeatList{state: state}
+// NewImportantHeartbeatList creates a new ImportantHeartbeatList handler. The publisher is optional
+// and receives every processed list as HeartbeatListData.
+func NewImportantHeartbeatList(state ImportantHeartbeatListState, publisher Publisher) *ImportantHeartbeatList {
+	return &ImportantHeartbeatList{state: state, publisher: publisher}
 }
 
 func (hn *Heartbeat) Event() string {
@@ -111,6 +127,11 @@ func (hn *Heartbeat) Callback(h *shadiaosocketio.Channel, data any) error {
 		return err
 	}
 
+	// publish heartbeat
+	if hn.publisher != nil {
+		hn.publisher.Publish(HeartbeatEvent, *heartbeat)
+	}
+
 	return nil
 }
 
@@ -143,6 +164,15 @@ func (hn *HeartbeatList) Callback(h *shadiaosocketio.Channel, id any, result []a
 		return err
 	}
 
+	// publish heartbeats
+	if hn.publisher != nil {
+		hn.publisher.Publish(HeartbeatListEvent, HeartbeatListData{
+			MonitorId:  response.MonitorId,
+			Heartbeats: heartbeats,
+			Overwrite:  response.Overwrite,
+		})
+	}
+
 	return nil
 }
 
@@ -175,5 +205,14 @@ func (hn *ImportantHeartbeatList) Callback(h *shadiaosocketio.Channel, id any, r
 		return err
 	}
 
+	// publish heartbeats
+	if hn.publisher != nil {
+		hn.publisher.Publish(ImportantHeartbeatListEvent, HeartbeatListData{
+			MonitorId:  response.MonitorId,
+			Heartbeats: heartbeats,
+			Overwrite:  response.Overwrite,
+		})
+	}
+
 	return nil
 }
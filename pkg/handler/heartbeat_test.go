@@ -13,26 +13,26 @@ import (
 )
 
 func TestHeartbeat_Event(t *testing.T) {
-	c := handler.NewHeartbeat(nil)
+	c := handler.NewHeartbeat(nil, nil)
 
 	assert.Equal(t, handler.HeartbeatEvent, c.Event())
 }
 
 func TestHeartbeatList_Event(t *testing.T) {
-	c := handler.NewHeartbeatList(nil)
+	c := handler.NewHeartbeatList(nil, nil)
 
 	assert.Equal(t, handler.HeartbeatListEvent, c.Event())
 }
 
 func TestImportantHeartbeatList_Event(t *testing.T) {
-	c := handler.NewImportantHeartbeatList(nil)
+	c := handler.NewImportantHeartbeatList(nil, nil)
 
 	assert.Equal(t, handler.ImportantHeartbeatListEvent, c.Event())
 }
 
 func TestHeartbeat_Register(t *testing.T) {
 	r := mocks.NewHandlerRegistrator(t)
-	c := handler.NewHeartbeat(nil)
+	c := handler.NewHeartbeat(nil, nil)
 
 	r.EXPECT().On(handler.HeartbeatEvent, mock.MatchedBy(func(any) bool {
 		return true
@@ -43,7 +43,7 @@ func TestHeartbeat_Register(t *testing.T) {
 
 func TestHeartbeatList_Register(t *testing.T) {
 	r := mocks.NewHandlerRegistrator(t)
-	c := handler.NewHeartbeatList(nil)
+	c := handler.NewHeartbeatList(nil, nil)
 
 	r.EXPECT().On(handler.HeartbeatListEvent, mock.MatchedBy(func(any) bool {
 		return true
@@ -54,7 +54,7 @@ func TestHeartbeatList_Register(t *testing.T) {
 
 func TestHeartbeatImportantList_Register(t *testing.T) {
 	r := mocks.NewHandlerRegistrator(t)
-	c := handler.NewImportantHeartbeatList(nil)
+	c := handler.NewImportantHeartbeatList(nil, nil)
 
 	r.EXPECT().On(handler.ImportantHeartbeatListEvent, mock.MatchedBy(func(any) bool {
 		return true
@@ -65,7 +65,7 @@ func TestHeartbeatImportantList_Register(t *testing.T) {
 
 func TestHeartbeatList_Occurred(t *testing.T) {
 	s := mocks.NewHeartbeatListState(t)
-	c := handler.NewHeartbeatList(s)
+	c := handler.NewHeartbeatList(s, nil)
 
 	s.EXPECT().Heartbeats(0).Return(nil, state.ErrNotSetYet).Once()
 	s.EXPECT().Heartbeats(0).Return([]state.Heartbeat{}, nil).Once()
@@ -76,7 +76,8 @@ func TestHeartbeatList_Occurred(t *testing.T) {
 
 func TestHeartbeatList_Callback(t *testing.T) {
 	type fields struct {
-		state *mocks.HeartbeatListState
+		state     *mocks.HeartbeatListState
+		publisher *mocks.Publisher
 	}
 
 	type args struct {
@@ -138,6 +139,32 @@ func TestHeartbeatList_Callback(t *testing.T) {
 				f.state.AssertExpectations(t)
 			},
 		},
+		{
+			name: "publish",
+			fields: &fields{
+				state:     mocks.NewHeartbeatListState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch:        &shadiaosocketio.Channel{},
+				id:        1,
+				result:    []any{},
+				overwrite: true,
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().SetHeartbeats(1, []state.Heartbeat{}, true).Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.HeartbeatListEvent, handler.HeartbeatListData{
+					MonitorId:  1,
+					Heartbeats: []state.Heartbeat{},
+					Overwrite:  true,
+				}).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
 		{
 			name: "decode failed",
 			fields: &fields{
@@ -198,7 +225,12 @@ func TestHeartbeatList_Callback(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// setup mocks
-			c := handler.NewHeartbeatList(tt.fields.state)
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewHeartbeatList(tt.fields.state, publisher)
 
 			if tt.on != nil {
 				tt.on(tt.fields)
@@ -18,11 +18,14 @@ type InfoState interface {
 }
 
 type Info struct {
-	state InfoState
+	state     InfoState
+	publisher Publisher
 }
 
-func NewInfo(state InfoState) *Info {
-	return &Info{state: state}
+// NewInfo creates a new Info handler. The publisher is optional and receives the processed info as
+// state.Info.
+func NewInfo(state InfoState, publisher Publisher) *Info {
+	return &Info{state: state, publisher: publisher}
 }
 
 func (i *Info) Event() string {
@@ -56,5 +59,10 @@ func (i *Info) Callback(ch *shadiaosocketio.Channel, data any) error {
 		return err
 	}
 
+	// publish info
+	if i.publisher != nil {
+		i.publisher.Publish(InfoEvent, *info)
+	}
+
 	return nil
 }
@@ -13,14 +13,14 @@ import (
 )
 
 func TestInfo_Event(t *testing.T) {
-	c := handler.NewInfo(nil)
+	c := handler.NewInfo(nil, nil)
 
 	assert.Equal(t, handler.InfoEvent, c.Event())
 }
 
 func TestInfo_Register(t *testing.T) {
 	r := mocks.NewHandlerRegistrator(t)
-	c := handler.NewInfo(nil)
+	c := handler.NewInfo(nil, nil)
 
 	r.EXPECT().On(handler.InfoEvent, mock.MatchedBy(func(any) bool {
 		return true
@@ -31,7 +31,7 @@ func TestInfo_Register(t *testing.T) {
 
 func TestInfo_Occurred(t *testing.T) {
 	s := mocks.NewInfoState(t)
-	c := handler.NewInfo(s)
+	c := handler.NewInfo(s, nil)
 
 	s.EXPECT().Info().Return(nil, state.ErrNotSetYet).Once()
 	s.EXPECT().Info().Return(&state.Info{}, nil).Once()
@@ -42,7 +42,8 @@ func TestInfo_Occurred(t *testing.T) {
 
 func TestInfo_Callback(t *testing.T) {
 	type fields struct {
-		state *mocks.InfoState
+		state     *mocks.InfoState
+		publisher *mocks.Publisher
 	}
 
 	type args struct {
@@ -88,6 +89,32 @@ func TestInfo_Callback(t *testing.T) {
 				f.state.AssertExpectations(t)
 			},
 		},
+		{
+			name: "publish",
+			fields: &fields{
+				state:     mocks.NewInfoState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch: &shadiaosocketio.Channel{},
+				data: map[string]any{
+					"version": "1.22.1",
+				},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().SetInfo(&state.Info{
+					Version: utils.NewString("1.22.1"),
+				}).Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.InfoEvent, state.Info{
+					Version: utils.NewString("1.22.1"),
+				}).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
 		{
 			name: "invalid data",
 			fields: &fields{
@@ -144,7 +171,12 @@ func TestInfo_Callback(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// setup mocks
-			c := handler.NewInfo(tt.fields.state)
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewInfo(tt.fields.state, publisher)
 
 			if tt.on != nil {
 				tt.on(tt.fields)
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+const (
+	MaintenanceListEvent = "maintenanceList"
+)
+
+type MaintenanceState interface {
+	SetMaintenances(maintenances map[int]*state.Maintenance) (err error)
+	HasSeen(event string) (seen bool)
+	MarkSeen(event string)
+}
+
+type MaintenanceList struct {
+	state     MaintenanceState
+	publisher Publisher
+}
+
+// NewMaintenanceList creates a new MaintenanceList handler. The publisher is optional and receives
+// the processed maintenances as map[int]*state.Maintenance.
+func NewMaintenanceList(state MaintenanceState, publisher Publisher) *MaintenanceList {
+	return &MaintenanceList{state: state, publisher: publisher}
+}
+
+func (ml MaintenanceList) Event() string {
+	return MaintenanceListEvent
+}
+
+func (ml MaintenanceList) Register(h HandlerRegistrator) error {
+	return h.On(MaintenanceListEvent, ml.Callback)
+}
+
+func (ml MaintenanceList) Occurred() bool {
+	return ml.state.HasSeen(MaintenanceListEvent)
+}
+
+func (ml MaintenanceList) Callback(ch *shadiaosocketio.Channel, data any) error {
+	ml.state.MarkSeen(MaintenanceListEvent)
+
+	// assert data type
+	typedData, ok := data.(map[string]any)
+	if !ok {
+		return NewErrInvalidDataType("map[string]any", data)
+	}
+
+	// decode data into struct
+	maintenances := make(map[int]*state.Maintenance)
+	if err := utils.DecodeMap(typedData, maintenances); err != nil {
+		return fmt.Errorf("decode failed: %w", err)
+	}
+
+	// set maintenances
+	if err := ml.state.SetMaintenances(maintenances); err != nil {
+		return err
+	}
+
+	// publish maintenances
+	if ml.publisher != nil {
+		ml.publisher.Publish(MaintenanceListEvent, maintenances)
+	}
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMaintenanceList_Event(t *testing.T) {
+	c := handler.NewMaintenanceList(nil, nil)
+
+	assert.Equal(t, handler.MaintenanceListEvent, c.Event())
+}
+
+func TestMaintenanceList_Register(t *testing.T) {
+	r := mocks.NewHandlerRegistrator(t)
+	c := handler.NewMaintenanceList(nil, nil)
+
+	r.EXPECT().On(handler.MaintenanceListEvent, mock.MatchedBy(func(any) bool {
+		return true
+	})).Return(nil).Once()
+
+	assert.NoError(t, c.Register(r))
+}
+
+func TestMaintenanceList_Occurred(t *testing.T) {
+	s := mocks.NewMaintenanceState(t)
+	c := handler.NewMaintenanceList(s, nil)
+
+	s.EXPECT().HasSeen(handler.MaintenanceListEvent).Return(false).Once()
+	s.EXPECT().HasSeen(handler.MaintenanceListEvent).Return(true).Once()
+
+	assert.False(t, c.Occurred())
+	assert.True(t, c.Occurred())
+}
+
+func TestMaintenanceList_Callback(t *testing.T) {
+	type fields struct {
+		state     *mocks.MaintenanceState
+		publisher *mocks.Publisher
+	}
+
+	type args struct {
+		ch   *shadiaosocketio.Channel
+		data any
+	}
+
+	maintenance := map[string]any{
+		"id":             1,
+		"title":          "Database upgrade",
+		"description":    "",
+		"strategy":       "recurring-weekday",
+		"intervalDay":    1,
+		"active":         true,
+		"dateRange":      []any{"2023-10-01 00:00:00"},
+		"timeRange":      []any{map[string]any{"hours": 2, "minutes": 0}, map[string]any{"hours": 3, "minutes": 30}},
+		"weekdays":       []any{1, 3},
+		"daysOfMonth":    []any{},
+		"timeslotList":   []any{map[string]any{"startDate": "2023-10-02 02:00:00", "endDate": "2023-10-02 03:30:00"}},
+		"cron":           "0 2 * * 1,3",
+		"duration":       5400,
+		"timezone":       "Europe/Berlin",
+		"timezoneOption": "SAME_AS_SERVER",
+		"timezoneOffset": "+02:00",
+		"status":         "scheduled",
+	}
+
+	want := map[int]*state.Maintenance{
+		1: {
+			Id:          1,
+			Title:       "Database upgrade",
+			Strategy:    state.MaintenanceStrategyRecurringWeekday,
+			IntervalDay: 1,
+			Active:      true,
+			DateRange:   []string{"2023-10-01 00:00:00"},
+			TimeRange:   []state.MaintenanceTime{{Hours: 2}, {Hours: 3, Minutes: 30}},
+			Weekdays:    []int{1, 3},
+			DaysOfMonth: []any{},
+			TimeslotList: []state.MaintenanceTimeslot{
+				{StartDate: "2023-10-02 02:00:00", EndDate: "2023-10-02 03:30:00"},
+			},
+			Cron:           "0 2 * * 1,3",
+			Duration:       5400,
+			Timezone:       "Europe/Berlin",
+			TimezoneOption: "SAME_AS_SERVER",
+			TimezoneOffset: "+02:00",
+			Status:         state.MaintenanceStatusScheduled,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		fields *fields
+		args   *args
+		want   *string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "ok",
+			fields: &fields{
+				state: mocks.NewMaintenanceState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: map[string]any{"1": maintenance},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.MaintenanceListEvent).Return().Once()
+				f.state.EXPECT().SetMaintenances(want).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "empty",
+			fields: &fields{
+				state: mocks.NewMaintenanceState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: map[string]any{},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.MaintenanceListEvent).Return().Once()
+				f.state.EXPECT().SetMaintenances(map[int]*state.Maintenance{}).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "publish",
+			fields: &fields{
+				state:     mocks.NewMaintenanceState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: map[string]any{"1": maintenance},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.MaintenanceListEvent).Return().Once()
+				f.state.EXPECT().SetMaintenances(want).Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.MaintenanceListEvent, want).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
+		{
+			name: "invalid data",
+			fields: &fields{
+				state: mocks.NewMaintenanceState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: "invalid data",
+			},
+			want: utils.NewString("invalid data type"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.MaintenanceListEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetMaintenances", mock.Anything)
+			},
+		},
+		{
+			name: "decode failed",
+			fields: &fields{
+				state: mocks.NewMaintenanceState(t),
+			},
+			args: &args{
+				ch: &shadiaosocketio.Channel{},
+				data: map[string]any{
+					"one": maintenance,
+				},
+			},
+			want: utils.NewString("decode failed"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.MaintenanceListEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetMaintenances", mock.Anything)
+			},
+		},
+		{
+			name: "set maintenances failed",
+			fields: &fields{
+				state: mocks.NewMaintenanceState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: map[string]any{},
+			},
+			want: utils.NewString(state.ErrStateNil.Error()),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.MaintenanceListEvent).Return().Once()
+				f.state.EXPECT().SetMaintenances(map[int]*state.Maintenance{}).Return(state.ErrStateNil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// setup mocks
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewMaintenanceList(tt.fields.state, publisher)
+
+			if tt.on != nil {
+				tt.on(tt.fields)
+			}
+
+			// run function
+			got := c.Callback(tt.args.ch, tt.args.data)
+
+			// assert results
+			if tt.want != nil && assert.NotNil(t, got) {
+				assert.ErrorContains(t, got, *tt.want)
+			}
+
+			if tt.want == nil {
+				assert.NoError(t, got)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, tt.fields)
+			}
+		})
+	}
+}
@@ -12,11 +12,14 @@ const (
 )
 
 type Message struct {
-	state *state.State
+	state     *state.State
+	publisher Publisher
 }
 
-func NewMessage(state *state.State) *Message {
-	return &Message{state}
+// NewMessage creates a new Message handler. The publisher is optional and receives the
+// RawEventData of every message, both under MessageEvent and under RawEvent.
+func NewMessage(state *state.State, publisher Publisher) *Message {
+	return &Message{state: state, publisher: publisher}
 }
 
 func (m *Message) Event() string {
@@ -28,11 +31,19 @@ func (m *Message) Register(h HandlerRegistrator) error {
 }
 
 func (m *Message) Occurred() bool {
-	// TODO: implement some kind of message handling here
-	return false
+	return m.state.HasSeen(MessageEvent)
 }
 
 func (m *Message) Callback(ch *shadiaosocketio.Channel, data any) error {
 	slog.Info("received message event", slog.Any("data", data))
+	m.state.MarkSeen(MessageEvent)
+
+	if m.publisher != nil {
+		raw := RawEventData{Event: MessageEvent, Args: []any{data}}
+
+		m.publisher.Publish(MessageEvent, raw)
+		m.publisher.Publish(RawEvent, raw)
+	}
+
 	return nil
 }
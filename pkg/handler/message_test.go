@@ -10,14 +10,14 @@ import (
 )
 
 func TestMessage_Event(t *testing.T) {
-	c := handler.NewMessage(nil)
+	c := handler.NewMessage(nil, nil)
 
 	assert.Equal(t, handler.MessageEvent, c.Event())
 }
 
 func TestMessage_Register(t *testing.T) {
 	r := mocks.NewHandlerRegistrator(t)
-	c := handler.NewMessage(nil)
+	c := handler.NewMessage(nil, nil)
 
 	r.EXPECT().On(handler.MessageEvent, mock.MatchedBy(func(any) bool {
 		return true
@@ -27,13 +27,25 @@ func TestMessage_Register(t *testing.T) {
 }
 
 func TestMessage_Occurred(t *testing.T) {
-	c := handler.NewMessage(nil)
+	c := handler.NewMessage(nil, nil)
 
 	assert.False(t, c.Occurred())
 }
 
 func TestMessage_Callback(t *testing.T) {
-	c := handler.NewMessage(nil)
+	c := handler.NewMessage(nil, nil)
 
 	assert.NoError(t, c.Callback(nil, nil))
 }
+
+func TestMessage_Callback_Publish(t *testing.T) {
+	p := mocks.NewPublisher(t)
+	c := handler.NewMessage(nil, p)
+
+	data := handler.RawEventData{Event: handler.MessageEvent, Args: []any{"message"}}
+
+	p.EXPECT().Publish(handler.MessageEvent, data).Return().Once()
+	p.EXPECT().Publish(handler.RawEvent, data).Return().Once()
+
+	assert.NoError(t, c.Callback(nil, "message"))
+}
@@ -20,11 +20,14 @@ type MonitorState interface {
 }
 
 type MonitorList struct {
-	state MonitorState
+	state     MonitorState
+	publisher Publisher
 }
 
-func NewMonitorList(state MonitorState) *MonitorList {
-	return &MonitorList{state: state}
+// NewMonitorList creates a new MonitorList handler. The publisher is optional and receives the
+// processed monitors as map[int]*state.Monitor.
+func NewMonitorList(state MonitorState, publisher Publisher) *MonitorList {
+	return &MonitorList{state: state, publisher: publisher}
 }
 
 func (ml MonitorList) Event() string {
@@ -60,5 +63,10 @@ func (ml MonitorList) Callback(ch *shadiaosocketio.Channel, data any) error {
 		return err
 	}
 
+	// publish monitors
+	if ml.publisher != nil {
+		ml.publisher.Publish(MonitorListEvent, monitors)
+	}
+
 	return nil
 }
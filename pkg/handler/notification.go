@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+const (
+	NotificationListEvent = "notificationList"
+)
+
+type NotificationState interface {
+	SetNotifications(notifications []state.Notification) (err error)
+	HasSeen(event string) (seen bool)
+	MarkSeen(event string)
+}
+
+type NotificationList struct {
+	state     NotificationState
+	publisher Publisher
+}
+
+// NewNotificationList creates a new NotificationList handler. The publisher is optional and
+// receives the processed notifications as []state.Notification.
+func NewNotificationList(state NotificationState, publisher Publisher) *NotificationList {
+	return &NotificationList{state: state, publisher: publisher}
+}
+
+func (nl NotificationList) Event() string {
+	return NotificationListEvent
+}
+
+func (nl NotificationList) Register(h HandlerRegistrator) error {
+	return h.On(NotificationListEvent, nl.Callback)
+}
+
+func (nl NotificationList) Occurred() bool {
+	return nl.state.HasSeen(NotificationListEvent)
+}
+
+func (nl NotificationList) Callback(ch *shadiaosocketio.Channel, data any) error {
+	nl.state.MarkSeen(NotificationListEvent)
+
+	// assert data type
+	typedData, ok := data.([]any)
+	if !ok {
+		return NewErrInvalidDataType("[]any", data)
+	}
+
+	// decode data into slice of notifications
+	notifications := make([]state.Notification, 0, len(typedData))
+
+	notifications, err := utils.DecodeSlice(typedData, notifications)
+	if err != nil {
+		return fmt.Errorf("decode failed: %w", err)
+	}
+
+	// set notifications
+	if err := nl.state.SetNotifications(notifications); err != nil {
+		return err
+	}
+
+	// publish notifications
+	if nl.publisher != nil {
+		nl.publisher.Publish(NotificationListEvent, notifications)
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotificationList_Event(t *testing.T) {
+	c := handler.NewNotificationList(nil, nil)
+
+	assert.Equal(t, handler.NotificationListEvent, c.Event())
+}
+
+func TestNotificationList_Register(t *testing.T) {
+	r := mocks.NewHandlerRegistrator(t)
+	c := handler.NewNotificationList(nil, nil)
+
+	r.EXPECT().On(handler.NotificationListEvent, mock.MatchedBy(func(any) bool {
+		return true
+	})).Return(nil).Once()
+
+	assert.NoError(t, c.Register(r))
+}
+
+func TestNotificationList_Occurred(t *testing.T) {
+	s := mocks.NewNotificationState(t)
+	c := handler.NewNotificationList(s, nil)
+
+	s.EXPECT().HasSeen(handler.NotificationListEvent).Return(false).Once()
+	s.EXPECT().HasSeen(handler.NotificationListEvent).Return(true).Once()
+
+	assert.False(t, c.Occurred())
+	assert.True(t, c.Occurred())
+}
+
+func TestNotificationList_Callback(t *testing.T) {
+	type fields struct {
+		state     *mocks.NotificationState
+		publisher *mocks.Publisher
+	}
+
+	type args struct {
+		ch   *shadiaosocketio.Channel
+		data any
+	}
+
+	notification := map[string]any{
+		"id":         1,
+		"name":       "Webhook",
+		"config":     `{"name":"Webhook","type":"webhook","isDefault":true,"webhookURL":"https://example.com"}`,
+		"active":     true,
+		"user_id":    1,
+		"is_default": 1,
+		"isDefault":  false,
+	}
+
+	want := []state.Notification{
+		{
+			Id:        1,
+			Name:      "Webhook",
+			Active:    true,
+			IsDefault: true,
+			UserId:    1,
+			Config:    `{"name":"Webhook","type":"webhook","isDefault":true,"webhookURL":"https://example.com"}`,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		fields *fields
+		args   *args
+		want   *string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "ok",
+			fields: &fields{
+				state: mocks.NewNotificationState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{notification},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.NotificationListEvent).Return().Once()
+				f.state.EXPECT().SetNotifications(want).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "empty",
+			fields: &fields{
+				state: mocks.NewNotificationState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.NotificationListEvent).Return().Once()
+				f.state.EXPECT().SetNotifications([]state.Notification{}).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "publish",
+			fields: &fields{
+				state:     mocks.NewNotificationState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{notification},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.NotificationListEvent).Return().Once()
+				f.state.EXPECT().SetNotifications(want).Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.NotificationListEvent, want).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
+		{
+			name: "invalid data",
+			fields: &fields{
+				state: mocks.NewNotificationState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: "invalid data",
+			},
+			want: utils.NewString("invalid data type"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.NotificationListEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetNotifications", mock.Anything)
+			},
+		},
+		{
+			name: "decode failed",
+			fields: &fields{
+				state: mocks.NewNotificationState(t),
+			},
+			args: &args{
+				ch: &shadiaosocketio.Channel{},
+				data: []any{
+					map[string]any{"id": "one"},
+				},
+			},
+			want: utils.NewString("decode failed"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.NotificationListEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetNotifications", mock.Anything)
+			},
+		},
+		{
+			name: "set notifications failed",
+			fields: &fields{
+				state: mocks.NewNotificationState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{},
+			},
+			want: utils.NewString(state.ErrStateNil.Error()),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.NotificationListEvent).Return().Once()
+				f.state.EXPECT().SetNotifications([]state.Notification{}).Return(state.ErrStateNil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// setup mocks
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewNotificationList(tt.fields.state, publisher)
+
+			if tt.on != nil {
+				tt.on(tt.fields)
+			}
+
+			// run function
+			got := c.Callback(tt.args.ch, tt.args.data)
+
+			// assert results
+			if tt.want != nil && assert.NotNil(t, got) {
+				assert.ErrorContains(t, got, *tt.want)
+			}
+
+			if tt.want == nil {
+				assert.NoError(t, got)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, tt.fields)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+const (
+	ProxyListEvent = "proxyList"
+)
+
+type ProxyState interface {
+	SetProxies(proxies []state.Proxy) (err error)
+	HasSeen(event string) (seen bool)
+	MarkSeen(event string)
+}
+
+type ProxyList struct {
+	state     ProxyState
+	publisher Publisher
+}
+
+// NewProxyList creates a new ProxyList handler. The publisher is optional and receives the
+// processed proxies as []state.Proxy.
+func NewProxyList(state ProxyState, publisher Publisher) *ProxyList {
+	return &ProxyList{state: state, publisher: publisher}
+}
+
+func (pl ProxyList) Event() string {
+	return ProxyListEvent
+}
+
+func (pl ProxyList) Register(h HandlerRegistrator) error {
+	return h.On(ProxyListEvent, pl.Callback)
+}
+
+func (pl ProxyList) Occurred() bool {
+	return pl.state.HasSeen(ProxyListEvent)
+}
+
+func (pl ProxyList) Callback(ch *shadiaosocketio.Channel, data any) error {
+	pl.state.MarkSeen(ProxyListEvent)
+
+	// assert data type
+	typedData, ok := data.([]any)
+	if !ok {
+		return NewErrInvalidDataType("[]any", data)
+	}
+
+	// decode data into slice of proxies
+	proxies := make([]state.Proxy, 0, len(typedData))
+
+	proxies, err := utils.DecodeSlice(typedData, proxies)
+	if err != nil {
+		return fmt.Errorf("decode failed: %w", err)
+	}
+
+	// set proxies
+	if err := pl.state.SetProxies(proxies); err != nil {
+		return err
+	}
+
+	// publish proxies
+	if pl.publisher != nil {
+		pl.publisher.Publish(ProxyListEvent, proxies)
+	}
+
+	return nil
+}
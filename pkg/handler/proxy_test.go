@@ -0,0 +1,237 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProxyList_Event(t *testing.T) {
+	c := handler.NewProxyList(nil, nil)
+
+	assert.Equal(t, handler.ProxyListEvent, c.Event())
+}
+
+func TestProxyList_Register(t *testing.T) {
+	r := mocks.NewHandlerRegistrator(t)
+	c := handler.NewProxyList(nil, nil)
+
+	r.EXPECT().On(handler.ProxyListEvent, mock.MatchedBy(func(any) bool {
+		return true
+	})).Return(nil).Once()
+
+	assert.NoError(t, c.Register(r))
+}
+
+func TestProxyList_Occurred(t *testing.T) {
+	s := mocks.NewProxyState(t)
+	c := handler.NewProxyList(s, nil)
+
+	s.EXPECT().HasSeen(handler.ProxyListEvent).Return(false).Once()
+	s.EXPECT().HasSeen(handler.ProxyListEvent).Return(true).Once()
+
+	assert.False(t, c.Occurred())
+	assert.True(t, c.Occurred())
+}
+
+func TestProxyList_Callback(t *testing.T) {
+	type fields struct {
+		state     *mocks.ProxyState
+		publisher *mocks.Publisher
+	}
+
+	type args struct {
+		ch   *shadiaosocketio.Channel
+		data any
+	}
+
+	proxy := map[string]any{
+		"id":           1,
+		"user_id":      1,
+		"protocol":     "https",
+		"host":         "proxy.example.com",
+		"port":         3128,
+		"auth":         1,
+		"username":     "user",
+		"password":     "secret",
+		"active":       1,
+		"default":      0,
+		"created_date": "2023-10-01 12:00:00",
+	}
+
+	want := []state.Proxy{
+		{
+			Id:          1,
+			UserId:      1,
+			Protocol:    "https",
+			Host:        "proxy.example.com",
+			Port:        3128,
+			Auth:        true,
+			Username:    utils.NewString("user"),
+			Password:    utils.NewString("secret"),
+			Active:      true,
+			Default:     false,
+			CreatedDate: utils.NewString("2023-10-01 12:00:00"),
+		},
+	}
+
+	tests := []struct {
+		name   string
+		fields *fields
+		args   *args
+		want   *string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "ok",
+			fields: &fields{
+				state: mocks.NewProxyState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{proxy},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.ProxyListEvent).Return().Once()
+				f.state.EXPECT().SetProxies(want).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "empty",
+			fields: &fields{
+				state: mocks.NewProxyState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.ProxyListEvent).Return().Once()
+				f.state.EXPECT().SetProxies([]state.Proxy{}).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "publish",
+			fields: &fields{
+				state:     mocks.NewProxyState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{proxy},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.ProxyListEvent).Return().Once()
+				f.state.EXPECT().SetProxies(want).Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.ProxyListEvent, want).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
+		{
+			name: "invalid data",
+			fields: &fields{
+				state: mocks.NewProxyState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: "invalid data",
+			},
+			want: utils.NewString("invalid data type"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.ProxyListEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetProxies", mock.Anything)
+			},
+		},
+		{
+			name: "decode failed",
+			fields: &fields{
+				state: mocks.NewProxyState(t),
+			},
+			args: &args{
+				ch: &shadiaosocketio.Channel{},
+				data: []any{
+					map[string]any{"id": "one"},
+				},
+			},
+			want: utils.NewString("decode failed"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.ProxyListEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetProxies", mock.Anything)
+			},
+		},
+		{
+			name: "set proxies failed",
+			fields: &fields{
+				state: mocks.NewProxyState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: []any{},
+			},
+			want: utils.NewString(state.ErrStateNil.Error()),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.ProxyListEvent).Return().Once()
+				f.state.EXPECT().SetProxies([]state.Proxy{}).Return(state.ErrStateNil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// setup mocks
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewProxyList(tt.fields.state, publisher)
+
+			if tt.on != nil {
+				tt.on(tt.fields)
+			}
+
+			// run function
+			got := c.Callback(tt.args.ch, tt.args.data)
+
+			// assert results
+			if tt.want != nil && assert.NotNil(t, got) {
+				assert.ErrorContains(t, got, *tt.want)
+			}
+
+			if tt.want == nil {
+				assert.NoError(t, got)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, tt.fields)
+			}
+		})
+	}
+}
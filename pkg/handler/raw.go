@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+)
+
+const (
+	// RawEvent is the event all raw handlers publish their data under, in addition to their own
+	// event. It is not sent by the server.
+	RawEvent = "raw"
+)
+
+// RawEvents lists the events pushed by the server that have no dedicated handler yet. They are
+// handled by Raw handlers, so their data is not silently dropped. The socket.io client drops every
+// event without a registered callback, so events missing from this list are never received, use
+// NewRaw with a custom handler for them.
+var RawEvents = []string{
+	"loginRequired",
+	"refresh",
+}
+
+type RawState interface {
+	HasSeen(event string) (seen bool)
+	MarkSeen(event string)
+}
+
+// RawEventData is the data published for events handled by a Raw or Message handler.
+type RawEventData struct {
+	// Event is the name of the event.
+	Event string
+
+	// Args are the decoded arguments of the event, without trailing nil arguments.
+	Args []any
+}
+
+// Raw is a generic handler for events without a dedicated handler. It does not process the event,
+// but forwards its arguments to the publisher.
+type Raw struct {
+	event     string
+	state     RawState
+	publisher Publisher
+}
+
+// NewRaw creates a new Raw handler for the given event. The publisher is optional and receives the
+// RawEventData of every event, both under the event itself and under RawEvent.
+func NewRaw(event string, state RawState, publisher Publisher) *Raw {
+	return &Raw{event: event, state: state, publisher: publisher}
+}
+
+// Event returns the event name.
+func (r *Raw) Event() string {
+	return r.event
+}
+
+// Register registers the handler with the client.
+func (r *Raw) Register(h HandlerRegistrator) error {
+	return h.On(r.event, r.Callback)
+}
+
+// Occurred returns true if the event has occurred, false otherwise.
+func (r *Raw) Occurred() bool {
+	return r.state.HasSeen(r.event)
+}
+
+// Callback is the function that is called when the event is received. The server sends at most
+// four arguments with the events in RawEvents, missing arguments are nil.
+func (r *Raw) Callback(ch *shadiaosocketio.Channel, arg0, arg1, arg2, arg3 any) error {
+	slog.Debug("received raw event", slog.String("event", r.event))
+	r.state.MarkSeen(r.event)
+
+	// drop trailing arguments that haven't been sent
+	args := []any{arg0, arg1, arg2, arg3}
+	for len(args) > 0 && args[len(args)-1] == nil {
+		args = args[:len(args)-1]
+	}
+
+	if r.publisher != nil {
+		data := RawEventData{Event: r.event, Args: args}
+
+		r.publisher.Publish(r.event, data)
+		r.publisher.Publish(RawEvent, data)
+	}
+
+	return nil
+}
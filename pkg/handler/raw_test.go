@@ -0,0 +1,150 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRaw_Event(t *testing.T) {
+	c := handler.NewRaw("uptime", nil, nil)
+
+	assert.Equal(t, "uptime", c.Event())
+}
+
+func TestRaw_Register(t *testing.T) {
+	r := mocks.NewHandlerRegistrator(t)
+	c := handler.NewRaw("uptime", nil, nil)
+
+	r.EXPECT().On("uptime", mock.MatchedBy(func(any) bool {
+		return true
+	})).Return(nil).Once()
+
+	assert.NoError(t, c.Register(r))
+}
+
+func TestRaw_Occurred(t *testing.T) {
+	s := mocks.NewRawState(t)
+	c := handler.NewRaw("uptime", s, nil)
+
+	s.EXPECT().HasSeen("uptime").Return(false).Once()
+	s.EXPECT().HasSeen("uptime").Return(true).Once()
+
+	assert.False(t, c.Occurred())
+	assert.True(t, c.Occurred())
+}
+
+func TestRaw_Callback(t *testing.T) {
+	type fields struct {
+		state     *mocks.RawState
+		publisher *mocks.Publisher
+	}
+
+	type args struct {
+		ch   *shadiaosocketio.Channel
+		args [4]any
+	}
+
+	tests := []struct {
+		name   string
+		fields *fields
+		args   *args
+		want   error
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "ok",
+			fields: &fields{
+				state: mocks.NewRawState(t),
+			},
+			args: &args{
+				ch:   new(shadiaosocketio.Channel),
+				args: [4]any{1, 24, 0.99},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen("uptime").Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "publish",
+			fields: &fields{
+				state:     mocks.NewRawState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch:   new(shadiaosocketio.Channel),
+				args: [4]any{1, 24, 0.99},
+			},
+			want: nil,
+			on: func(f *fields) {
+				data := handler.RawEventData{Event: "uptime", Args: []any{1, 24, 0.99}}
+
+				f.state.EXPECT().MarkSeen("uptime").Return().Once()
+				f.publisher.EXPECT().Publish("uptime", data).Return().Once()
+				f.publisher.EXPECT().Publish(handler.RawEvent, data).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
+		{
+			name: "no arguments",
+			fields: &fields{
+				state:     mocks.NewRawState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch: new(shadiaosocketio.Channel),
+			},
+			want: nil,
+			on: func(f *fields) {
+				data := handler.RawEventData{Event: "uptime", Args: []any{}}
+
+				f.state.EXPECT().MarkSeen("uptime").Return().Once()
+				f.publisher.EXPECT().Publish("uptime", data).Return().Once()
+				f.publisher.EXPECT().Publish(handler.RawEvent, data).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// setup mocks
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewRaw("uptime", tt.fields.state, publisher)
+
+			if tt.on != nil {
+				tt.on(tt.fields)
+			}
+
+			// run function
+			got := c.Callback(tt.args.ch, tt.args.args[0], tt.args.args[1], tt.args.args[2], tt.args.args[3])
+
+			// assert results
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, tt.fields)
+			}
+		})
+	}
+}
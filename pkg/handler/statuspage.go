@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+const (
+	StatusPageListEvent = "statusPageList"
+)
+
+type StatusPageState interface {
+	SetStatusPages(statusPages map[int]*state.StatusPage) (err error)
+	HasSeen(event string) (seen bool)
+	MarkSeen(event string)
+}
+
+type StatusPageList struct {
+	state     StatusPageState
+	publisher Publisher
+}
+
+// NewStatusPageList creates a new StatusPageList handler. The publisher is optional and receives
+// the processed status pages as map[int]*state.StatusPage.
+func NewStatusPageList(state StatusPageState, publisher Publisher) *StatusPageList {
+	return &StatusPageList{state: state, publisher: publisher}
+}
+
+func (sl StatusPageList) Event() string {
+	return StatusPageListEvent
+}
+
+func (sl StatusPageList) Register(h HandlerRegistrator) error {
+	return h.On(StatusPageListEvent, sl.Callback)
+}
+
+func (sl StatusPageList) Occurred() bool {
+	return sl.state.HasSeen(StatusPageListEvent)
+}
+
+func (sl StatusPageList) Callback(ch *shadiaosocketio.Channel, data any) error {
+	sl.state.MarkSeen(StatusPageListEvent)
+
+	// assert data type
+	typedData, ok := data.(map[string]any)
+	if !ok {
+		return NewErrInvalidDataType("map[string]any", data)
+	}
+
+	// decode data into struct
+	statusPages := make(map[int]*state.StatusPage)
+	if err := utils.DecodeMap(typedData, statusPages); err != nil {
+		return fmt.Errorf("decode failed: %w", err)
+	}
+
+	// set status pages
+	if err := sl.state.SetStatusPages(statusPages); err != nil {
+		return err
+	}
+
+	// publish status pages
+	if sl.publisher != nil {
+		sl.publisher.Publish(StatusPageListEvent, statusPages)
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStatusPageList_Event(t *testing.T) {
+	c := handler.NewStatusPageList(nil, nil)
+
+	assert.Equal(t, handler.StatusPageListEvent, c.Event())
+}
+
+func TestStatusPageList_Register(t *testing.T) {
+	r := mocks.NewHandlerRegistrator(t)
+	c := handler.NewStatusPageList(nil, nil)
+
+	r.EXPECT().On(handler.StatusPageListEvent, mock.MatchedBy(func(any) bool {
+		return true
+	})).Return(nil).Once()
+
+	assert.NoError(t, c.Register(r))
+}
+
+func TestStatusPageList_Occurred(t *testing.T) {
+	s := mocks.NewStatusPageState(t)
+	c := handler.NewStatusPageList(s, nil)
+
+	s.EXPECT().HasSeen(handler.StatusPageListEvent).Return(false).Once()
+	s.EXPECT().HasSeen(handler.StatusPageListEvent).Return(true).Once()
+
+	assert.False(t, c.Occurred())
+	assert.True(t, c.Occurred())
+}
+
+func TestStatusPageList_Callback(t *testing.T) {
+	type fields struct {
+		state     *mocks.StatusPageState
+		publisher *mocks.Publisher
+	}
+
+	type args struct {
+		ch   *shadiaosocketio.Channel
+		data any
+	}
+
+	statusPage := map[string]any{
+		"id":                    1,
+		"slug":                  "status",
+		"title":                 "Status",
+		"description":           nil,
+		"icon":                  "/icon.svg",
+		"theme":                 "auto",
+		"autoRefreshInterval":   300,
+		"published":             true,
+		"showTags":              false,
+		"domainNameList":        []any{"status.example.com"},
+		"customCSS":             "body {}",
+		"footerText":            nil,
+		"showPoweredBy":         true,
+		"googleAnalyticsId":     nil,
+		"showCertificateExpiry": false,
+	}
+
+	want := map[int]*state.StatusPage{
+		1: {
+			Id:                  1,
+			Slug:                "status",
+			Title:               "Status",
+			Icon:                "/icon.svg",
+			Theme:               "auto",
+			AutoRefreshInterval: 300,
+			Published:           true,
+			DomainNameList:      []string{"status.example.com"},
+			CustomCSS:           utils.NewString("body {}"),
+			ShowPoweredBy:       true,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		fields *fields
+		args   *args
+		want   *string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "ok",
+			fields: &fields{
+				state: mocks.NewStatusPageState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: map[string]any{"1": statusPage},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.StatusPageListEvent).Return().Once()
+				f.state.EXPECT().SetStatusPages(want).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "empty",
+			fields: &fields{
+				state: mocks.NewStatusPageState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: map[string]any{},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.StatusPageListEvent).Return().Once()
+				f.state.EXPECT().SetStatusPages(map[int]*state.StatusPage{}).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "publish",
+			fields: &fields{
+				state:     mocks.NewStatusPageState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: map[string]any{"1": statusPage},
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.StatusPageListEvent).Return().Once()
+				f.state.EXPECT().SetStatusPages(want).Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.StatusPageListEvent, want).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
+		{
+			name: "invalid data",
+			fields: &fields{
+				state: mocks.NewStatusPageState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: "invalid data",
+			},
+			want: utils.NewString("invalid data type"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.StatusPageListEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetStatusPages", mock.Anything)
+			},
+		},
+		{
+			name: "decode failed",
+			fields: &fields{
+				state: mocks.NewStatusPageState(t),
+			},
+			args: &args{
+				ch: &shadiaosocketio.Channel{},
+				data: map[string]any{
+					"one": statusPage,
+				},
+			},
+			want: utils.NewString("decode failed"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.StatusPageListEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetStatusPages", mock.Anything)
+			},
+		},
+		{
+			name: "set status pages failed",
+			fields: &fields{
+				state: mocks.NewStatusPageState(t),
+			},
+			args: &args{
+				ch:   &shadiaosocketio.Channel{},
+				data: map[string]any{},
+			},
+			want: utils.NewString(state.ErrStateNil.Error()),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.StatusPageListEvent).Return().Once()
+				f.state.EXPECT().SetStatusPages(map[int]*state.StatusPage{}).Return(state.ErrStateNil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// setup mocks
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewStatusPageList(tt.fields.state, publisher)
+
+			if tt.on != nil {
+				tt.on(tt.fields)
+			}
+
+			// run function
+			got := c.Callback(tt.args.ch, tt.args.data)
+
+			// assert results
+			if tt.want != nil && assert.NotNil(t, got) {
+				assert.ErrorContains(t, got, *tt.want)
+			}
+
+			if tt.want == nil {
+				assert.NoError(t, got)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, tt.fields)
+			}
+		})
+	}
+}
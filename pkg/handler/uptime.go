@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+const (
+	UptimeEvent = "uptime"
+)
+
+type UptimeState interface {
+	SetUptime(monitorId int, window state.UptimeWindow, uptime float64) (err error)
+	HasSeen(event string) (seen bool)
+	MarkSeen(event string)
+}
+
+// UptimeData is the data published for uptime events.
+type UptimeData struct {
+	MonitorId int
+	Window    state.UptimeWindow
+	Uptime    float64
+}
+
+type Uptime struct {
+	state     UptimeState
+	publisher Publisher
+}
+
+// NewUptime creates a new Uptime handler. The publisher is optional and receives every processed
+// uptime as UptimeData.
+func NewUptime(state UptimeState, publisher Publisher) *Uptime {
+	return &Uptime{state: state, publisher: publisher}
+}
+
+func (u Uptime) Event() string {
+	return UptimeEvent
+}
+
+func (u Uptime) Register(h HandlerRegistrator) error {
+	return h.On(UptimeEvent, u.Callback)
+}
+
+func (u Uptime) Occurred() bool {
+	return u.state.HasSeen(UptimeEvent)
+}
+
+func (u Uptime) Callback(ch *shadiaosocketio.Channel, id, window, uptime any) error {
+	u.state.MarkSeen(UptimeEvent)
+
+	// decode monitorId, window and uptime, the window is sent as number of hours or as string
+	data := &UptimeData{}
+	if err := utils.Decode(map[string]any{"monitorId": id, "window": window, "uptime": uptime}, data); err != nil {
+		return fmt.Errorf("decode failed: %w", err)
+	}
+
+	// set uptime
+	if err := u.state.SetUptime(data.MonitorId, data.Window, data.Uptime); err != nil {
+		return err
+	}
+
+	// publish uptime
+	if u.publisher != nil {
+		u.publisher.Publish(UptimeEvent, *data)
+	}
+
+	return nil
+}
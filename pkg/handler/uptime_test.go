@@ -0,0 +1,204 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/Baiguoshuai1/shadiaosocketio"
+	"github.com/nobbs/uptime-kuma-api/mocks"
+	"github.com/nobbs/uptime-kuma-api/pkg/handler"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUptime_Event(t *testing.T) {
+	c := handler.NewUptime(nil, nil)
+
+	assert.Equal(t, handler.UptimeEvent, c.Event())
+}
+
+func TestUptime_Register(t *testing.T) {
+	r := mocks.NewHandlerRegistrator(t)
+	c := handler.NewUptime(nil, nil)
+
+	r.EXPECT().On(handler.UptimeEvent, mock.MatchedBy(func(any) bool {
+		return true
+	})).Return(nil).Once()
+
+	assert.NoError(t, c.Register(r))
+}
+
+func TestUptime_Occurred(t *testing.T) {
+	s := mocks.NewUptimeState(t)
+	c := handler.NewUptime(s, nil)
+
+	s.EXPECT().HasSeen(handler.UptimeEvent).Return(false).Once()
+	s.EXPECT().HasSeen(handler.UptimeEvent).Return(true).Once()
+
+	assert.False(t, c.Occurred())
+	assert.True(t, c.Occurred())
+}
+
+func TestUptime_Callback(t *testing.T) {
+	type fields struct {
+		state     *mocks.UptimeState
+		publisher *mocks.Publisher
+	}
+
+	type args struct {
+		ch     *shadiaosocketio.Channel
+		id     any
+		window any
+		uptime any
+	}
+
+	tests := []struct {
+		name   string
+		fields *fields
+		args   *args
+		want   *string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "ok",
+			fields: &fields{
+				state: mocks.NewUptimeState(t),
+			},
+			args: &args{
+				ch:     &shadiaosocketio.Channel{},
+				id:     1.0,
+				window: 24.0,
+				uptime: 0.995,
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.UptimeEvent).Return().Once()
+				f.state.EXPECT().SetUptime(1, state.UptimeWindow24h, 0.995).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "string window",
+			fields: &fields{
+				state: mocks.NewUptimeState(t),
+			},
+			args: &args{
+				ch:     &shadiaosocketio.Channel{},
+				id:     1.0,
+				window: "1y",
+				uptime: 1,
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.UptimeEvent).Return().Once()
+				f.state.EXPECT().SetUptime(1, state.UptimeWindow1y, 1.0).Return(nil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+		{
+			name: "publish",
+			fields: &fields{
+				state:     mocks.NewUptimeState(t),
+				publisher: mocks.NewPublisher(t),
+			},
+			args: &args{
+				ch:     &shadiaosocketio.Channel{},
+				id:     2.0,
+				window: 720.0,
+				uptime: 0.5,
+			},
+			want: nil,
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.UptimeEvent).Return().Once()
+				f.state.EXPECT().SetUptime(2, state.UptimeWindow30d, 0.5).Return(nil).Once()
+				f.publisher.EXPECT().Publish(handler.UptimeEvent, handler.UptimeData{
+					MonitorId: 2,
+					Window:    state.UptimeWindow30d,
+					Uptime:    0.5,
+				}).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+				f.publisher.AssertExpectations(t)
+			},
+		},
+		{
+			name: "decode failed",
+			fields: &fields{
+				state: mocks.NewUptimeState(t),
+			},
+			args: &args{
+				ch:     &shadiaosocketio.Channel{},
+				id:     "one",
+				window: 24.0,
+				uptime: 1.0,
+			},
+			want: utils.NewString("decode failed"),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.UptimeEvent).Return().Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertNotCalled(t, "SetUptime", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "set uptime failed",
+			fields: &fields{
+				state: mocks.NewUptimeState(t),
+			},
+			args: &args{
+				ch:     &shadiaosocketio.Channel{},
+				id:     1.0,
+				window: 24.0,
+				uptime: 1.0,
+			},
+			want: utils.NewString(state.ErrStateNil.Error()),
+			on: func(f *fields) {
+				f.state.EXPECT().MarkSeen(handler.UptimeEvent).Return().Once()
+				f.state.EXPECT().SetUptime(1, state.UptimeWindow24h, 1.0).Return(state.ErrStateNil).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.state.AssertExpectations(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// setup mocks
+			var publisher handler.Publisher
+			if tt.fields.publisher != nil {
+				publisher = tt.fields.publisher
+			}
+
+			c := handler.NewUptime(tt.fields.state, publisher)
+
+			if tt.on != nil {
+				tt.on(tt.fields)
+			}
+
+			// run function
+			got := c.Callback(tt.args.ch, tt.args.id, tt.args.window, tt.args.uptime)
+
+			// assert results
+			if tt.want != nil && assert.NotNil(t, got) {
+				assert.ErrorContains(t, got, *tt.want)
+			}
+
+			if tt.want == nil {
+				assert.NoError(t, got)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, tt.fields)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+// Package httpclient provides a client for the parts of the Uptime Kuma API that are only served
+// over plain HTTP, like public status pages, badges and metrics.
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+const (
+	httpScheme       = "http"
+	httpSecureScheme = "https"
+
+	// maxErrorBody limits the part of the body included in ErrUnexpectedStatus.
+	maxErrorBody = 1024
+)
+
+// Client sends requests to the HTTP API of an Uptime Kuma instance.
+type Client struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+	options    *options
+}
+
+// StatusPage is the data of a public status page.
+type StatusPage struct {
+	Config          state.StatusPage    `mapstructure:"config"`
+	Incident        *state.Incident     `mapstructure:"incident"`
+	PublicGroupList []state.PublicGroup `mapstructure:"publicGroupList"`
+	MaintenanceList []state.Maintenance `mapstructure:"maintenanceList"`
+}
+
+// StatusPageHeartbeats are the recent heartbeats and the uptime of the monitors on a status page.
+type StatusPageHeartbeats struct {
+	// HeartbeatList holds the recent heartbeats, keyed by monitor id.
+	HeartbeatList map[int][]state.Heartbeat
+
+	// UptimeList holds the uptime of the last 24 hours as ratio between 0 and 1, keyed by monitor
+	// id.
+	UptimeList map[int]float64
+}
+
+// BadgeOptions customize the badges. Not all options apply to all badges.
+type BadgeOptions struct {
+	// Style is one of "flat", "flat-square", "plastic", "for-the-badge" or "social".
+	Style       string
+	Label       string
+	LabelPrefix string
+	LabelSuffix string
+	LabelColor  string
+	Prefix      string
+	Suffix      string
+	Color       string
+
+	// Query holds additional query parameters, e.g. upLabel for the status badge.
+	Query url.Values
+}
+
+// values returns the options as query parameters.
+func (b *BadgeOptions) values() url.Values {
+	values := url.Values{}
+	if b == nil {
+		return values
+	}
+
+	for key, value := range b.Query {
+		values[key] = append([]string(nil), value...)
+	}
+
+	for key, value := range map[string]string{
+		"style":       b.Style,
+		"label":       b.Label,
+		"labelPrefix": b.LabelPrefix,
+		"labelSuffix": b.LabelSuffix,
+		"labelColor":  b.LabelColor,
+		"prefix":      b.Prefix,
+		"suffix":      b.Suffix,
+		"color":       b.Color,
+	} {
+		if value != "" {
+			values.Set(key, value)
+		}
+	}
+
+	return values
+}
+
+// NewClient creates a new client for the Uptime Kuma instance at the given host and port, using the
+// same configuration as the socket.io client. It can be customized with options, e.g. WithBasePath.
+func NewClient(host string, port int, secure bool, opts ...Option) *Client {
+	scheme := httpScheme
+	if secure {
+		scheme = httpSecureScheme
+	}
+
+	return newClient(&url.URL{Scheme: scheme, Host: net.JoinHostPort(host, strconv.Itoa(port))}, opts...)
+}
+
+// NewClientWithURL creates a new client for the Uptime Kuma instance at the given base url, e.g.
+// "https://uptime.example.com/uptime".
+func NewClientWithURL(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base url: %w", err)
+	}
+
+	if u.Scheme != httpScheme && u.Scheme != httpSecureScheme {
+		return nil, fmt.Errorf("invalid base url: unsupported scheme %q", u.Scheme)
+	}
+
+	return newClient(u, opts...), nil
+}
+
+// newClient creates a new client for the given base url.
+func newClient(baseURL *url.URL, opts ...Option) *Client {
+	o := newOptions(opts...)
+
+	u := *baseURL
+	u.Path = path.Join("/", u.Path, o.basePath)
+
+	return &Client{baseURL: &u, httpClient: o.client(), options: o}
+}
+
+// StatusPage returns the data of the published status page with the given slug.
+func (c *Client) StatusPage(ctx context.Context, slug string) (*StatusPage, error) {
+	raw := map[string]any{}
+	if err := c.getJSON(ctx, path.Join("api/status-page", url.PathEscape(slug)), &raw); err != nil {
+		return nil, err
+	}
+
+	statusPage := &StatusPage{}
+	if err := utils.Decode(raw, statusPage); err != nil {
+		return nil, fmt.Errorf("decode failed: %w", err)
+	}
+
+	return statusPage, nil
+}
+
+// StatusPageHeartbeats returns the recent heartbeats and the uptime of the monitors on the
+// published status page with the given slug.
+func (c *Client) StatusPageHeartbeats(ctx context.Context, slug string) (*StatusPageHeartbeats, error) {
+	raw := struct {
+		HeartbeatList map[string]any     `json:"heartbeatList"`
+		UptimeList    map[string]float64 `json:"uptimeList"`
+	}{}
+	if err := c.getJSON(ctx, path.Join("api/status-page/heartbeat", url.PathEscape(slug)), &raw); err != nil {
+		return nil, err
+	}
+
+	heartbeats := &StatusPageHeartbeats{
+		HeartbeatList: make(map[int][]state.Heartbeat, len(raw.HeartbeatList)),
+		UptimeList:    make(map[int]float64, len(raw.UptimeList)),
+	}
+
+	if err := utils.DecodeMap(raw.HeartbeatList, heartbeats.HeartbeatList); err != nil {
+		return nil, fmt.Errorf("decode failed: %w", err)
+	}
+
+	// uptimes are keyed by monitor id and duration in hours, e.g. "1_24"
+	for key, uptime := range raw.UptimeList {
+		monitorId, err := strconv.Atoi(strings.SplitN(key, "_", 2)[0])
+		if err != nil {
+			return nil, fmt.Errorf("decode failed: invalid uptime key %q", key)
+		}
+
+		heartbeats.UptimeList[monitorId] = uptime
+	}
+
+	return heartbeats, nil
+}
+
+// StatusBadge returns the SVG badge showing the current status of the monitor with the given id.
+// The monitor must be shown on a published status page.
+func (c *Client) StatusBadge(ctx context.Context, monitorId int, opts *BadgeOptions) ([]byte, error) {
+	return c.badge(ctx, monitorId, "status", 0, opts)
+}
+
+// UptimeBadge returns the SVG badge showing the uptime of the monitor with the given id over the
+// given duration in hours, or the last 24 hours if the duration is 0.
+func (c *Client) UptimeBadge(ctx context.Context, monitorId, durationHours int, opts *BadgeOptions) ([]byte, error) {
+	return c.badge(ctx, monitorId, "uptime", durationHours, opts)
+}
+
+// PingBadge returns the SVG badge showing the average ping of the monitor with the given id over
+// the given duration in hours, or the last 24 hours if the duration is 0.
+func (c *Client) PingBadge(ctx context.Context, monitorId, durationHours int, opts *BadgeOptions) ([]byte, error) {
+	return c.badge(ctx, monitorId, "ping", durationHours, opts)
+}
+
+// CertExpBadge returns the SVG badge showing the days until the certificate of the monitor with the
+// given id expires.
+func (c *Client) CertExpBadge(ctx context.Context, monitorId int, opts *BadgeOptions) ([]byte, error) {
+	return c.badge(ctx, monitorId, "cert-exp", 0, opts)
+}
+
+// ResponseBadge returns the SVG badge showing the last response time of the monitor with the given
+// id.
+func (c *Client) ResponseBadge(ctx context.Context, monitorId int, opts *BadgeOptions) ([]byte, error) {
+	return c.badge(ctx, monitorId, "response", 0, opts)
+}
+
+// badge returns the SVG badge of the given kind, a duration of 0 uses the default of the server.
+func (c *Client) badge(ctx context.Context, monitorId int, kind string, durationHours int, opts *BadgeOptions) ([]byte, error) {
+	p := path.Join("api/badge", strconv.Itoa(monitorId), kind)
+	if durationHours > 0 {
+		p = path.Join(p, strconv.Itoa(durationHours))
+	}
+
+	return c.get(ctx, p, opts.values())
+}
+
+// Metrics returns the metrics of the Uptime Kuma instance in the Prometheus text format. The
+// endpoint requires authentication, see WithBasicAuth and WithAPIKey.
+func (c *Client) Metrics(ctx context.Context) ([]byte, error) {
+	return c.get(ctx, "metrics", nil)
+}
+
+// getJSON sends a GET request to the given path and decodes the JSON response into result.
+func (c *Client) getJSON(ctx context.Context, p string, result any) error {
+	body, err := c.get(ctx, p, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("decode failed: %w", err)
+	}
+
+	return nil
+}
+
+// get sends a GET request to the given path, relative to the base url, and returns the body of the
+// response.
+func (c *Client) get(ctx context.Context, p string, query url.Values) ([]byte, error) {
+	u := *c.baseURL
+	u.Path = path.Join(u.Path, p)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("request creation failed: %w", err)
+	}
+
+	for key, values := range c.options.header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	if c.options.password != nil {
+		req.SetBasicAuth(c.options.username, *c.options.password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request to %s failed: %w", u.Path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response of %s failed: %w", u.Path, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if len(body) > maxErrorBody {
+			body = body[:maxErrorBody]
+		}
+
+		return nil, &ErrUnexpectedStatus{Path: u.Path, StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	return body, nil
+}
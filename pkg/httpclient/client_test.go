@@ -0,0 +1,256 @@
+package httpclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/httpclient"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const statusPageResponse = `{
+	"config": {
+		"id": 1,
+		"slug": "status",
+		"title": "Status",
+		"description": null,
+		"icon": "/icon.svg",
+		"theme": "auto",
+		"published": true,
+		"showTags": false,
+		"domainNameList": [],
+		"customCSS": "body {}",
+		"footerText": null,
+		"showPoweredBy": true,
+		"googleAnalyticsId": null,
+		"showCertificateExpiry": false
+	},
+	"incident": {
+		"id": 2,
+		"style": "warning",
+		"title": "Degraded",
+		"content": "We are investigating.",
+		"pin": true,
+		"createdDate": "2023-10-01 12:00:00",
+		"lastUpdatedDate": null
+	},
+	"publicGroupList": [
+		{
+			"id": 3,
+			"name": "Services",
+			"weight": 1,
+			"monitorList": [
+				{"id": 4, "name": "API", "sendUrl": 0, "type": "http"}
+			]
+		}
+	],
+	"maintenanceList": []
+}`
+
+const heartbeatResponse = `{
+	"heartbeatList": {
+		"4": [
+			{"status": 1, "time": "2023-10-01 12:00:00", "msg": "", "ping": 42}
+		]
+	},
+	"uptimeList": {
+		"4_24": 0.995
+	}
+}`
+
+// newServer returns a test server mimicking the HTTP API of Uptime Kuma under the given base path.
+func newServer(t *testing.T, basePath string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(basePath+"/api/status-page/status", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(statusPageResponse))
+	})
+
+	mux.HandleFunc(basePath+"/api/status-page/heartbeat/status", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(heartbeatResponse))
+	})
+
+	mux.HandleFunc(basePath+"/api/status-page/missing", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"msg":"Not Found"}`, http.StatusNotFound)
+	})
+
+	mux.HandleFunc(basePath+"/api/badge/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/svg+xml")
+		_, _ = w.Write([]byte("<svg>" + r.URL.Path + "?" + r.URL.RawQuery + "</svg>"))
+	})
+
+	mux.HandleFunc(basePath+"/metrics", func(w http.ResponseWriter, r *http.Request) {
+		if _, password, ok := r.BasicAuth(); !ok || password != "uk1_key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte("monitor_status{monitor_name=\"API\"} 1\n"))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestNewClientWithURL(t *testing.T) {
+	_, err := httpclient.NewClientWithURL("ftp://example.com")
+	assert.ErrorContains(t, err, "unsupported scheme")
+
+	_, err = httpclient.NewClientWithURL("https://example.com/uptime")
+	assert.NoError(t, err)
+}
+
+func TestClient_StatusPage(t *testing.T) {
+	server := newServer(t, "/uptime")
+
+	c, err := httpclient.NewClientWithURL(server.URL, httpclient.WithBasePath("/uptime"))
+	assert.NoError(t, err)
+
+	got, err := c.StatusPage(context.Background(), "status")
+	assert.NoError(t, err)
+
+	assert.Equal(t, &httpclient.StatusPage{
+		Config: state.StatusPage{
+			Id:             1,
+			Slug:           "status",
+			Title:          "Status",
+			Icon:           "/icon.svg",
+			Theme:          "auto",
+			Published:      true,
+			DomainNameList: []string{},
+			CustomCSS:      utils.NewString("body {}"),
+			ShowPoweredBy:  true,
+		},
+		Incident: &state.Incident{
+			Id:          2,
+			Style:       "warning",
+			Title:       "Degraded",
+			Content:     "We are investigating.",
+			Pin:         true,
+			CreatedDate: "2023-10-01 12:00:00",
+		},
+		PublicGroupList: []state.PublicGroup{
+			{
+				Id:     3,
+				Name:   "Services",
+				Weight: 1,
+				MonitorList: []state.PublicMonitor{
+					{Id: 4, Name: "API", Type: "http"},
+				},
+			},
+		},
+		MaintenanceList: []state.Maintenance{},
+	}, got)
+}
+
+func TestClient_StatusPage_NotFound(t *testing.T) {
+	server := newServer(t, "")
+
+	c, err := httpclient.NewClientWithURL(server.URL)
+	assert.NoError(t, err)
+
+	_, err = c.StatusPage(context.Background(), "missing")
+
+	var statusErr *httpclient.ErrUnexpectedStatus
+	if assert.True(t, errors.As(err, &statusErr)) {
+		assert.Equal(t, http.StatusNotFound, statusErr.StatusCode)
+		assert.Equal(t, "/api/status-page/missing", statusErr.Path)
+	}
+}
+
+func TestClient_StatusPageHeartbeats(t *testing.T) {
+	server := newServer(t, "")
+
+	c, err := httpclient.NewClientWithURL(server.URL)
+	assert.NoError(t, err)
+
+	got, err := c.StatusPageHeartbeats(context.Background(), "status")
+	assert.NoError(t, err)
+
+	assert.Equal(t, &httpclient.StatusPageHeartbeats{
+		HeartbeatList: map[int][]state.Heartbeat{
+			4: {{Status: true, Time: "2023-10-01 12:00:00", Ping: 42}},
+		},
+		UptimeList: map[int]float64{4: 0.995},
+	}, got)
+}
+
+func TestClient_Badges(t *testing.T) {
+	server := newServer(t, "")
+
+	c, err := httpclient.NewClientWithURL(server.URL)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	opts := &httpclient.BadgeOptions{Style: "flat-square", Query: url.Values{"upLabel": {"online"}}}
+
+	tests := []struct {
+		name  string
+		badge func() ([]byte, error)
+		want  string
+	}{
+		{
+			name:  "status",
+			badge: func() ([]byte, error) { return c.StatusBadge(ctx, 1, opts) },
+			want:  "<svg>/api/badge/1/status?style=flat-square&upLabel=online</svg>",
+		},
+		{
+			name:  "uptime",
+			badge: func() ([]byte, error) { return c.UptimeBadge(ctx, 1, 720, nil) },
+			want:  "<svg>/api/badge/1/uptime/720?</svg>",
+		},
+		{
+			name:  "ping default duration",
+			badge: func() ([]byte, error) { return c.PingBadge(ctx, 1, 0, &httpclient.BadgeOptions{Suffix: "ms"}) },
+			want:  "<svg>/api/badge/1/ping?suffix=ms</svg>",
+		},
+		{
+			name:  "cert-exp",
+			badge: func() ([]byte, error) { return c.CertExpBadge(ctx, 1, nil) },
+			want:  "<svg>/api/badge/1/cert-exp?</svg>",
+		},
+		{
+			name:  "response",
+			badge: func() ([]byte, error) { return c.ResponseBadge(ctx, 1, nil) },
+			want:  "<svg>/api/badge/1/response?</svg>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.badge()
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestClient_Metrics(t *testing.T) {
+	server := newServer(t, "")
+
+	// without authentication
+	c, err := httpclient.NewClientWithURL(server.URL)
+	assert.NoError(t, err)
+
+	_, err = c.Metrics(context.Background())
+	assert.ErrorContains(t, err, "failed with status 401")
+
+	// with api key
+	c, err = httpclient.NewClientWithURL(server.URL, httpclient.WithAPIKey("uk1_key"))
+	assert.NoError(t, err)
+
+	got, err := c.Metrics(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "monitor_status{monitor_name=\"API\"} 1\n", string(got))
+}
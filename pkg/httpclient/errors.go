@@ -0,0 +1,15 @@
+package httpclient
+
+import "fmt"
+
+// ErrUnexpectedStatus is returned when the server responds with a status code other than 200.
+type ErrUnexpectedStatus struct {
+	Path       string
+	StatusCode int
+	Body       string
+}
+
+// Error returns the error message.
+func (e *ErrUnexpectedStatus) Error() string {
+	return fmt.Sprintf("request to %s failed with status %d: %s", e.Path, e.StatusCode, e.Body)
+}
@@ -0,0 +1,116 @@
+package httpclient
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+)
+
+// defaultTimeout is the timeout of requests if no http.Client is given.
+const defaultTimeout = 30 * time.Second
+
+// Option configures a client, see NewClient.
+type Option func(*options)
+
+// options stores the configuration collected from all options.
+type options struct {
+	// httpClient is used to send the requests.
+	httpClient *http.Client
+
+	// tlsConfig is the TLS configuration used for secure connections.
+	tlsConfig *tls.Config
+
+	// header stores additional headers sent with every request.
+	header http.Header
+
+	// basePath is the path Uptime Kuma is served under, e.g. behind a reverse proxy.
+	basePath string
+
+	// username and password are sent as basic auth, e.g. for the metrics endpoint.
+	username string
+	password *string
+}
+
+// newOptions returns the default options with the given options applied.
+func newOptions(opts ...Option) *options {
+	o := &options{
+		header: http.Header{},
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// client returns the http.Client to use, creating one with the TLS configuration if none is given.
+func (o *options) client() *http.Client {
+	if o.httpClient != nil {
+		return o.httpClient
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if o.tlsConfig != nil {
+		transport.TLSClientConfig = o.tlsConfig
+	}
+
+	return &http.Client{Transport: transport, Timeout: defaultTimeout}
+}
+
+// WithHTTPClient sets the http.Client used to send requests. The TLS options are ignored, as the
+// client is responsible for the connection.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = client
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used for secure connections.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = config.Clone()
+	}
+}
+
+// WithInsecureSkipVerify disables the verification of the server certificate. This should only be
+// used for testing.
+func WithInsecureSkipVerify() Option {
+	return func(o *options) {
+		if o.tlsConfig == nil {
+			o.tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+
+		o.tlsConfig.InsecureSkipVerify = true //nolint:gosec // explicitly requested by the user
+	}
+}
+
+// WithHeader adds a header sent with every request, e.g. for authenticating proxies.
+func WithHeader(key, value string) Option {
+	return func(o *options) {
+		o.header.Add(key, value)
+	}
+}
+
+// WithBasePath sets the path Uptime Kuma is served under, e.g. "/uptime" if a reverse proxy serves
+// it on a sub path.
+func WithBasePath(basePath string) Option {
+	return func(o *options) {
+		o.basePath = basePath
+	}
+}
+
+// WithBasicAuth sets the credentials sent as basic auth with every request. Uptime Kuma requires
+// them for the metrics endpoint.
+func WithBasicAuth(username, password string) Option {
+	return func(o *options) {
+		o.username = username
+		o.password = &password
+	}
+}
+
+// WithAPIKey authenticates requests with an API key, which Uptime Kuma accepts as basic auth
+// password for the metrics endpoint.
+func WithAPIKey(key string) Option {
+	return WithBasicAuth("", key)
+}
@@ -0,0 +1,126 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+)
+
+// PushStatus is the status reported to a push monitor.
+type PushStatus string
+
+const (
+	PushStatusUp   PushStatus = "up"
+	PushStatusDown PushStatus = "down"
+)
+
+// defaultPushMessage is the message of a successful push sent by the helpers.
+const defaultPushMessage = "OK"
+
+// reportTimeout is the timeout of the push reporting the result of a function, which is sent even if
+// the context of the function is done.
+const reportTimeout = 10 * time.Second
+
+// pushResponse is the response of the push endpoint.
+type pushResponse struct {
+	Ok  bool   `json:"ok"`
+	Msg string `json:"msg"`
+}
+
+// PushURL returns the push url of the push monitor with the given token, as shown by Uptime Kuma.
+func (c *Client) PushURL(token string) string {
+	u := *c.baseURL
+	u.Path = path.Join(u.Path, "api/push", token)
+	u.RawQuery = "status=up&msg=OK&ping="
+
+	return u.String()
+}
+
+// MonitorPushURL returns the push url of the push monitor with the given id, using the push token
+// stored in the state.
+func (c *Client) MonitorPushURL(s *state.State, monitorId int) (string, error) {
+	token, err := s.PushToken(monitorId)
+	if err != nil {
+		return "", err
+	}
+
+	return c.PushURL(token), nil
+}
+
+// Push sends a heartbeat to the push monitor with the given token. The ping is sent in
+// milliseconds, a ping of 0 is omitted.
+func (c *Client) Push(ctx context.Context, token string, status PushStatus, msg string, ping time.Duration) error {
+	query := url.Values{}
+	query.Set("status", string(status))
+	query.Set("msg", msg)
+
+	if ping > 0 {
+		query.Set("ping", strconv.FormatInt(ping.Milliseconds(), 10))
+	}
+
+	body, err := c.get(ctx, path.Join("api/push", token), query)
+	if err != nil {
+		// the server reports unknown or inactive monitors with a message
+		var statusErr *ErrUnexpectedStatus
+		if errors.As(err, &statusErr) {
+			response := pushResponse{}
+			if json.Unmarshal([]byte(statusErr.Body), &response) == nil && response.Msg != "" {
+				return fmt.Errorf("push failed: %s: %w", response.Msg, err)
+			}
+		}
+
+		return err
+	}
+
+	response := pushResponse{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return fmt.Errorf("decode failed: %w", err)
+	}
+
+	if !response.Ok {
+		return fmt.Errorf("push failed: %s", response.Msg)
+	}
+
+	return nil
+}
+
+// PushFunc runs fn and reports its result to the push monitor with the given token: up with the
+// duration of fn as ping if it succeeds, down with the error as message otherwise. The result is
+// reported even if ctx is done, e.g. when fn failed due to a timeout. The returned error joins the
+// error of fn and the error of the push, if any.
+func (c *Client) PushFunc(ctx context.Context, token string, fn func(ctx context.Context) error) error {
+	start := time.Now()
+	fnErr := fn(ctx)
+	duration := time.Since(start)
+
+	status, msg := PushStatusUp, defaultPushMessage
+	if fnErr != nil {
+		status, msg = PushStatusDown, fnErr.Error()
+	}
+
+	reportCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), reportTimeout)
+	defer cancel()
+
+	return errors.Join(fnErr, c.Push(reportCtx, token, status, msg, duration))
+}
+
+// PushCommand runs the named command with the given arguments and reports its result to the push
+// monitor with the given token, see PushFunc. The command is killed if the context is done before it
+// exits. A command is considered failed if it can't be started or exits with a non-zero status.
+func (c *Client) PushCommand(ctx context.Context, token string, name string, args ...string) error {
+	return c.PushFunc(ctx, token, func(ctx context.Context) error {
+		if err := exec.CommandContext(ctx, name, args...).Run(); err != nil {
+			return fmt.Errorf("command %s failed: %w", name, err)
+		}
+
+		return nil
+	})
+}
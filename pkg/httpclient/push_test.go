@@ -0,0 +1,181 @@
+package httpclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os/exec"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/httpclient"
+	"github.com/nobbs/uptime-kuma-api/pkg/state"
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// pushServer records the queries of all pushes to the token "valid".
+type pushServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	queries []url.Values
+}
+
+func newPushServer(t *testing.T) *pushServer {
+	t.Helper()
+
+	s := &pushServer{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/push/valid", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.queries = append(s.queries, r.URL.Query())
+		s.mu.Unlock()
+
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	})
+	mux.HandleFunc("/api/push/invalid", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"ok":false,"msg":"Monitor not found or not active."}`))
+	})
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *pushServer) last() url.Values {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.queries) == 0 {
+		return nil
+	}
+
+	return s.queries[len(s.queries)-1]
+}
+
+func TestClient_Push(t *testing.T) {
+	server := newPushServer(t)
+
+	c, err := httpclient.NewClientWithURL(server.URL)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	assert.NoError(t, c.Push(ctx, "valid", httpclient.PushStatusUp, "OK", 42*time.Millisecond))
+	assert.Equal(t, url.Values{"status": {"up"}, "msg": {"OK"}, "ping": {"42"}}, server.last())
+
+	assert.NoError(t, c.Push(ctx, "valid", httpclient.PushStatusDown, "backup failed", 0))
+	assert.Equal(t, url.Values{"status": {"down"}, "msg": {"backup failed"}}, server.last())
+
+	err = c.Push(ctx, "invalid", httpclient.PushStatusUp, "OK", 0)
+	assert.ErrorContains(t, err, "push failed: Monitor not found or not active.")
+
+	var statusErr *httpclient.ErrUnexpectedStatus
+	assert.True(t, errors.As(err, &statusErr))
+}
+
+func TestClient_PushFunc(t *testing.T) {
+	server := newPushServer(t)
+
+	c, err := httpclient.NewClientWithURL(server.URL)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	// successful function
+	err = c.PushFunc(ctx, "valid", func(context.Context) error {
+		time.Sleep(5 * time.Millisecond)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "up", server.last().Get("status"))
+	assert.Equal(t, "OK", server.last().Get("msg"))
+	assert.NotEmpty(t, server.last().Get("ping"))
+
+	// failing function
+	fnErr := errors.New("connection refused")
+	err = c.PushFunc(ctx, "valid", func(context.Context) error {
+		return fnErr
+	})
+	assert.ErrorIs(t, err, fnErr)
+	assert.Equal(t, "down", server.last().Get("status"))
+	assert.Equal(t, "connection refused", server.last().Get("msg"))
+
+	// function failing due to a done context, the result is reported nonetheless
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Millisecond)
+	defer cancel()
+
+	err = c.PushFunc(timeoutCtx, "valid", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.NotContains(t, err.Error(), "push")
+	assert.Equal(t, "down", server.last().Get("status"))
+	assert.Equal(t, context.DeadlineExceeded.Error(), server.last().Get("msg"))
+
+	// failing push
+	err = c.PushFunc(ctx, "invalid", func(context.Context) error {
+		return nil
+	})
+	assert.ErrorContains(t, err, "push failed")
+}
+
+func TestClient_PushCommand(t *testing.T) {
+	if _, err := exec.LookPath("false"); err != nil {
+		t.Skip("false command not available")
+	}
+
+	server := newPushServer(t)
+
+	c, err := httpclient.NewClientWithURL(server.URL)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+
+	assert.NoError(t, c.PushCommand(ctx, "valid", "true"))
+	assert.Equal(t, "up", server.last().Get("status"))
+
+	err = c.PushCommand(ctx, "valid", "false")
+	assert.ErrorContains(t, err, "exit status 1")
+	assert.Equal(t, "down", server.last().Get("status"))
+
+	// the command is killed once the context is done
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = c.PushCommand(ctx, "valid", "sleep", "10")
+	assert.ErrorContains(t, err, "command sleep failed")
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Equal(t, "down", server.last().Get("status"))
+	assert.Contains(t, server.last().Get("msg"), "command sleep failed")
+}
+
+func TestClient_MonitorPushURL(t *testing.T) {
+	s := state.NewState()
+	assert.NoError(t, s.SetMonitors(map[int]*state.Monitor{
+		1: {Id: 1, Type: "push", PushToken: utils.NewString("abc123")},
+		2: {Id: 2, Type: "http"},
+	}))
+
+	c, err := httpclient.NewClientWithURL("https://uptime.example.com", httpclient.WithBasePath("/kuma"))
+	assert.NoError(t, err)
+
+	got, err := c.MonitorPushURL(s, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://uptime.example.com/kuma/api/push/abc123?status=up&msg=OK&ping=", got)
+
+	_, err = c.MonitorPushURL(s, 2)
+	assert.ErrorIs(t, err, state.ErrNoPushToken)
+
+	_, err = c.MonitorPushURL(s, 3)
+	assert.ErrorContains(t, err, "monitor with id 3 not found")
+}
@@ -0,0 +1,88 @@
+package state
+
+import "time"
+
+// APIKeyStatus is the status of an API key as computed by Uptime Kuma.
+type APIKeyStatus string
+
+const (
+	APIKeyStatusActive   APIKeyStatus = "active"
+	APIKeyStatusInactive APIKeyStatus = "inactive"
+	APIKeyStatusExpired  APIKeyStatus = "expired"
+)
+
+// APIKey represents an API key as sent by Uptime Kuma in the apiKeyList event. The key itself is
+// only returned once, when it is added.
+type APIKey struct {
+	Id          int          `mapstructure:"id"`
+	Name        string       `mapstructure:"name"`
+	UserId      int          `mapstructure:"userID"`
+	CreatedDate time.Time    `mapstructure:"createdDate"`
+	Active      bool         `mapstructure:"active"`
+	Status      APIKeyStatus `mapstructure:"status"`
+
+	// Expires is nil if the key never expires. The server sends it in its local time, see
+	// Info.ServerTimezone, which is decoded as UTC like all dates.
+	Expires *time.Time `mapstructure:"expires"`
+}
+
+// APIKeys returns the API keys received from Uptime Kuma.
+func (s *State) APIKeys() ([]APIKey, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.apiKeys == nil {
+		return nil, ErrNotSetYet
+	}
+
+	// Convert map to slice.
+	apiKeys := make([]APIKey, 0, len(s.apiKeys))
+	for _, apiKey := range s.apiKeys {
+		apiKeys = append(apiKeys, *apiKey)
+	}
+
+	return apiKeys, nil
+}
+
+// APIKey returns the API key with the given id.
+func (s *State) APIKey(apiKeyId int) (*APIKey, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.apiKeys == nil {
+		return nil, ErrNotSetYet
+	}
+
+	apiKey, ok := s.apiKeys[apiKeyId]
+	if !ok {
+		return nil, NewErrNotFound("api key", apiKeyId)
+	}
+
+	return apiKey, nil
+}
+
+// SetAPIKeys sets the API keys received from Uptime Kuma.
+func (s *State) SetAPIKeys(apiKeys []APIKey) error {
+	if s == nil {
+		return ErrStateNil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Convert slice to map.
+	s.apiKeys = make(map[int]*APIKey, len(apiKeys))
+	for i := range apiKeys {
+		s.apiKeys[apiKeys[i].Id] = &apiKeys[i]
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package state
+
+import "time"
+
+// CertInfo represents the TLS information of a monitor as sent by Uptime Kuma in the certInfo
+// event.
+type CertInfo struct {
+	Valid                   bool         `mapstructure:"valid"`
+	HostnameMatchMonitorUrl bool         `mapstructure:"hostnameMatchMonitorUrl"`
+	Certificate             *Certificate `mapstructure:"certInfo"`
+}
+
+// Certificate represents a certificate of the chain presented by the monitored server.
+type Certificate struct {
+	Subject        map[string]any `mapstructure:"subject"`
+	Issuer         map[string]any `mapstructure:"issuer"`
+	SubjectAltName string         `mapstructure:"subjectaltname"`
+	ValidFor       []string       `mapstructure:"validFor"`
+	SerialNumber   string         `mapstructure:"serialNumber"`
+	Fingerprint    string         `mapstructure:"fingerprint"`
+	Fingerprint256 string         `mapstructure:"fingerprint256"`
+	ValidFrom      string         `mapstructure:"valid_from"`
+	ValidTo        time.Time      `mapstructure:"validTo"`
+	DaysRemaining  int            `mapstructure:"daysRemaining"`
+	CertType       string         `mapstructure:"certType"`
+
+	// IssuerCertificate is the next certificate of the chain, nil for the root certificate.
+	IssuerCertificate *Certificate `mapstructure:"issuerCertificate"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain"`
+}
+
+// Chain returns the certificate chain, starting with the server certificate.
+func (c *CertInfo) Chain() []Certificate {
+	chain := []Certificate{}
+	for cert := c.Certificate; cert != nil; cert = cert.IssuerCertificate {
+		chain = append(chain, *cert)
+	}
+
+	return chain
+}
+
+// CertInfo returns the TLS information of the monitor with the given id.
+func (s *State) CertInfo(monitorId int) (*CertInfo, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.certInfos == nil {
+		return nil, ErrNotSetYet
+	}
+
+	certInfo, ok := s.certInfos[monitorId]
+	if !ok {
+		return nil, NewErrNotFound("cert info", monitorId)
+	}
+
+	return certInfo, nil
+}
+
+// SetCertInfo sets the TLS information of the monitor with the given id.
+func (s *State) SetCertInfo(monitorId int, certInfo *CertInfo) error {
+	if s == nil {
+		return ErrStateNil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.certInfos == nil {
+		s.certInfos = make(map[int]*CertInfo)
+	}
+
+	s.certInfos[monitorId] = certInfo
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package state
+
+// Cloudflared represents the status of the built-in Cloudflare Tunnel as sent by Uptime Kuma in the
+// cloudflared events. Fields are nil until the corresponding event has been received.
+type Cloudflared struct {
+	Installed    *bool
+	Running      *bool
+	Message      *string
+	ErrorMessage *string
+	Token        *string
+}
+
+// Cloudflared returns the status of the Cloudflare Tunnel received from Uptime Kuma. The status is
+// only sent after joining with the cloudflared_join event.
+func (s *State) Cloudflared() (*Cloudflared, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.cloudflared == nil {
+		return nil, ErrNotSetYet
+	}
+
+	cloudflared := *s.cloudflared
+
+	return &cloudflared, nil
+}
+
+// SetCloudflaredInstalled sets whether cloudflared is installed on the server.
+func (s *State) SetCloudflaredInstalled(installed bool) error {
+	return s.updateCloudflared(func(c *Cloudflared) { c.Installed = &installed })
+}
+
+// SetCloudflaredRunning sets whether the Cloudflare Tunnel is running.
+func (s *State) SetCloudflaredRunning(running bool) error {
+	return s.updateCloudflared(func(c *Cloudflared) { c.Running = &running })
+}
+
+// SetCloudflaredMessage sets the last message of cloudflared.
+func (s *State) SetCloudflaredMessage(msg string) error {
+	return s.updateCloudflared(func(c *Cloudflared) { c.Message = &msg })
+}
+
+// SetCloudflaredErrorMessage sets the last error message of cloudflared.
+func (s *State) SetCloudflaredErrorMessage(msg string) error {
+	return s.updateCloudflared(func(c *Cloudflared) { c.ErrorMessage = &msg })
+}
+
+// SetCloudflaredToken sets the tunnel token stored on the server, empty if there is none.
+func (s *State) SetCloudflaredToken(token string) error {
+	return s.updateCloudflared(func(c *Cloudflared) { c.Token = &token })
+}
+
+// updateCloudflared applies the update to the cloudflared status, creating it if necessary.
+func (s *State) updateCloudflared(update func(*Cloudflared)) error {
+	if s == nil {
+		return ErrStateNil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cloudflared == nil {
+		s.cloudflared = &Cloudflared{}
+	}
+
+	update(s.cloudflared)
+
+	return nil
+}
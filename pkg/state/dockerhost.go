@@ -0,0 +1,84 @@
+package state
+
+// DockerHostType defines how Uptime Kuma connects to a docker daemon.
+type DockerHostType string
+
+const (
+	// DockerHostTypeSocket connects to the daemon using a unix socket, e.g. /var/run/docker.sock.
+	DockerHostTypeSocket DockerHostType = "socket"
+
+	// DockerHostTypeTCP connects to the daemon using TCP or HTTP, e.g. tcp://localhost:2375.
+	DockerHostTypeTCP DockerHostType = "tcp"
+)
+
+// DockerHost represents a docker host object.
+type DockerHost struct {
+	Id     int    `mapstructure:"id" json:"-"`
+	UserId int    `mapstructure:"userID" json:"-"`
+	Name   string `mapstructure:"name" json:"name"`
+
+	// DockerDaemon is the socket path or the URL of the daemon, depending on the DockerType.
+	DockerDaemon string         `mapstructure:"dockerDaemon" json:"dockerDaemon"`
+	DockerType   DockerHostType `mapstructure:"dockerType" json:"dockerType"`
+}
+
+// DockerHosts returns the docker hosts received from Uptime Kuma.
+func (s *State) DockerHosts() ([]DockerHost, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.dockerHosts == nil {
+		return nil, ErrNotSetYet
+	}
+
+	// Convert map to slice.
+	dockerHosts := make([]DockerHost, 0, len(s.dockerHosts))
+	for _, dockerHost := range s.dockerHosts {
+		dockerHosts = append(dockerHosts, *dockerHost)
+	}
+
+	return dockerHosts, nil
+}
+
+// DockerHost returns the docker host with the given id.
+func (s *State) DockerHost(dockerHostId int) (*DockerHost, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.dockerHosts == nil {
+		return nil, ErrNotSetYet
+	}
+
+	dockerHost, ok := s.dockerHosts[dockerHostId]
+	if !ok {
+		return nil, NewErrNotFound("docker host", dockerHostId)
+	}
+
+	return dockerHost, nil
+}
+
+// SetDockerHosts sets the docker hosts received from Uptime Kuma.
+func (s *State) SetDockerHosts(dockerHosts []DockerHost) error {
+	if s == nil {
+		return ErrStateNil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Convert slice to map.
+	s.dockerHosts = make(map[int]*DockerHost, len(dockerHosts))
+	for i := range dockerHosts {
+		s.dockerHosts[dockerHosts[i].Id] = &dockerHosts[i]
+	}
+
+	return nil
+}
@@ -13,12 +13,18 @@ var (
 	// ErrNotSetYet is returned when the value is not set yet - this may be the case
 	// if the client has not yet received the event that sets the value.
 	ErrNotSetYet = errors.New("value not set yet")
+
+	// ErrNoPushToken is returned when a push token is requested for a monitor that is not a push
+	// monitor or has no token yet.
+	ErrNoPushToken = errors.New("monitor has no push token")
 )
 
-// ErrNotFound is returned when a resource for a given ID is not found in the current state cache.
+// ErrNotFound is returned when a resource for a given ID or name is not found in the current state
+// cache.
 type ErrNotFound struct {
 	Kind string
 	Id   int
+	Name string
 }
 
 // NewErrNotFound returns a new ErrNotFound.
@@ -29,7 +35,19 @@ func NewErrNotFound(kind string, id int) *ErrNotFound {
 	}
 }
 
+// NewErrNotFoundByName returns a new ErrNotFound for a lookup by name.
+func NewErrNotFoundByName(kind, name string) *ErrNotFound {
+	return &ErrNotFound{
+		Kind: kind,
+		Name: name,
+	}
+}
+
 // Error returns the error message.
 func (e *ErrNotFound) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("%s with name %q not found", e.Kind, e.Name)
+	}
+
 	return fmt.Sprintf("%s with id %d not found", e.Kind, e.Id)
 }
@@ -0,0 +1,88 @@
+package state
+
+// Game represents a game supported by gamedig monitors as returned by Uptime Kuma in response to the
+// getGameList action.
+type Game struct {
+	// Keys are the values accepted as game of a gamedig monitor.
+	Keys    []string       `mapstructure:"keys"`
+	Pretty  string         `mapstructure:"pretty"`
+	Options GameOptions    `mapstructure:"options"`
+	Extra   map[string]any `mapstructure:"extra"`
+}
+
+// GameOptions are the query options of a game.
+type GameOptions struct {
+	// Port is the default port of the game, 0 if the game has none.
+	Port     int    `mapstructure:"port"`
+	Protocol string `mapstructure:"protocol"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain"`
+}
+
+// HasKey returns true if the given key identifies the game.
+func (g *Game) HasKey(key string) bool {
+	for _, k := range g.Keys {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Games returns the games supported by gamedig monitors.
+func (s *State) Games() ([]Game, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.games == nil {
+		return nil, ErrNotSetYet
+	}
+
+	games := make([]Game, len(s.games))
+	copy(games, s.games)
+
+	return games, nil
+}
+
+// Game returns the game identified by the given key.
+func (s *State) Game(key string) (*Game, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.games == nil {
+		return nil, ErrNotSetYet
+	}
+
+	for i := range s.games {
+		if s.games[i].HasKey(key) {
+			game := s.games[i]
+			return &game, nil
+		}
+	}
+
+	return nil, NewErrNotFoundByName("game", key)
+}
+
+// SetGames sets the games supported by gamedig monitors.
+func (s *State) SetGames(games []Game) error {
+	if s == nil {
+		return ErrStateNil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.games = games
+
+	return nil
+}
@@ -193,3 +193,19 @@ func (s *State) AppendHeartbeat(beat *Heartbeat) error {
 
 	return nil
 }
+
+// ClearHeartbeats removes all stored heartbeats and important heartbeats, e.g. before the server
+// resends them after a reconnect.
+func (s *State) ClearHeartbeats() error {
+	if s == nil {
+		return ErrStateNil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.heartbeats = make(map[int]HeartbeatQueue)
+	s.importantHeartbeats = make(map[int]HeartbeatQueue)
+
+	return nil
+}
@@ -33,3 +33,38 @@ func (s *State) SetLoggedIn(loggedIn bool) error {
 
 	return nil
 }
+
+// Token returns the token of the last successful login.
+func (s *State) Token() (string, error) {
+	if s == nil {
+		return "", ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.token == nil {
+		return "", ErrNotSetYet
+	}
+
+	return *s.token, nil
+}
+
+// SetToken sets the token of the last successful login. An empty token clears the stored token.
+func (s *State) SetToken(token string) error {
+	if s == nil {
+		return ErrStateNil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if token == "" {
+		s.token = nil
+		return nil
+	}
+
+	s.token = utils.NewString(token)
+
+	return nil
+}
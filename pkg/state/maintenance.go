@@ -0,0 +1,159 @@
+package state
+
+// MaintenanceStrategy defines when a maintenance is active.
+type MaintenanceStrategy string
+
+const (
+	// MaintenanceStrategyManual is active while the maintenance is active, until it is paused.
+	MaintenanceStrategyManual MaintenanceStrategy = "manual"
+
+	// MaintenanceStrategySingle is active once, within the DateRange.
+	MaintenanceStrategySingle MaintenanceStrategy = "single"
+
+	// MaintenanceStrategyRecurringInterval is active every IntervalDay days within the TimeRange.
+	MaintenanceStrategyRecurringInterval MaintenanceStrategy = "recurring-interval"
+
+	// MaintenanceStrategyRecurringWeekday is active on the Weekdays within the TimeRange.
+	MaintenanceStrategyRecurringWeekday MaintenanceStrategy = "recurring-weekday"
+
+	// MaintenanceStrategyRecurringDayOfMonth is active on the DaysOfMonth within the TimeRange.
+	MaintenanceStrategyRecurringDayOfMonth MaintenanceStrategy = "recurring-day-of-month"
+
+	// MaintenanceStrategyCron is active for DurationMinutes each time the Cron expression matches.
+	MaintenanceStrategyCron MaintenanceStrategy = "cron"
+)
+
+// MaintenanceStatus is the current status of a maintenance as computed by Uptime Kuma.
+type MaintenanceStatus string
+
+const (
+	MaintenanceStatusInactive         MaintenanceStatus = "inactive"
+	MaintenanceStatusScheduled        MaintenanceStatus = "scheduled"
+	MaintenanceStatusUnderMaintenance MaintenanceStatus = "under-maintenance"
+	MaintenanceStatusEnded            MaintenanceStatus = "ended"
+	MaintenanceStatusUnknown          MaintenanceStatus = "unknown"
+)
+
+// MaintenanceTime is a time of day used in the time range of recurring maintenances.
+type MaintenanceTime struct {
+	Hours   int `mapstructure:"hours" json:"hours"`
+	Minutes int `mapstructure:"minutes" json:"minutes"`
+}
+
+// MaintenanceTimeslot is a time window in which a maintenance is active.
+type MaintenanceTimeslot struct {
+	StartDate string `mapstructure:"startDate" json:"startDate"`
+	EndDate   string `mapstructure:"endDate" json:"endDate"`
+}
+
+// Maintenance represents a maintenance object.
+type Maintenance struct {
+	Id          int                 `mapstructure:"id" json:"id,omitempty"`
+	Title       string              `mapstructure:"title" json:"title"`
+	Description string              `mapstructure:"description" json:"description"`
+	Strategy    MaintenanceStrategy `mapstructure:"strategy" json:"strategy"`
+	Active      bool                `mapstructure:"active" json:"active"`
+
+	// IntervalDay is the interval in days of the recurring-interval strategy.
+	IntervalDay int `mapstructure:"intervalDay" json:"intervalDay"`
+
+	// DateRange holds the start and end date, formatted as "2006-01-02 15:04". It is required for
+	// the single strategy and limits the recurring strategies.
+	DateRange []string `mapstructure:"dateRange" json:"dateRange"`
+
+	// TimeRange holds the start and end time of the recurring strategies.
+	TimeRange []MaintenanceTime `mapstructure:"timeRange" json:"timeRange"`
+
+	// Weekdays of the recurring-weekday strategy, 0 is Sunday.
+	Weekdays []int `mapstructure:"weekdays" json:"weekdays"`
+
+	// DaysOfMonth of the recurring-day-of-month strategy, either 1 to 31 or "lastDay1" for the last
+	// day of the month.
+	DaysOfMonth []any `mapstructure:"daysOfMonth" json:"daysOfMonth"`
+
+	// Cron and DurationMinutes are used by the cron strategy.
+	Cron            string `mapstructure:"cron" json:"cron,omitempty"`
+	DurationMinutes int    `mapstructure:"durationMinutes" json:"durationMinutes"`
+
+	// TimezoneOption is the configured timezone, "SAME_AS_SERVER", "UTC" or an IANA name.
+	TimezoneOption string `mapstructure:"timezoneOption" json:"timezoneOption,omitempty"`
+
+	// fields computed by Uptime Kuma, they are ignored when sending a maintenance
+	Duration       int                   `mapstructure:"duration" json:"-"`
+	Timezone       string                `mapstructure:"timezone" json:"-"`
+	TimezoneOffset string                `mapstructure:"timezoneOffset" json:"-"`
+	Status         MaintenanceStatus     `mapstructure:"status" json:"-"`
+	TimeslotList   []MaintenanceTimeslot `mapstructure:"timeslotList" json:"-"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+// Maintenances returns all maintenances received from Uptime Kuma.
+func (s *State) Maintenances() (map[int]*Maintenance, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.maintenances == nil {
+		return nil, ErrNotSetYet
+	}
+
+	return s.maintenances, nil
+}
+
+// Maintenance returns the maintenance with the given id.
+func (s *State) Maintenance(maintenanceId int) (*Maintenance, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.maintenances == nil {
+		return nil, ErrNotSetYet
+	}
+
+	maintenance, ok := s.maintenances[maintenanceId]
+	if !ok {
+		return nil, NewErrNotFound("maintenance", maintenanceId)
+	}
+
+	return maintenance, nil
+}
+
+// SetMaintenances sets the maintenances received from Uptime Kuma.
+func (s *State) SetMaintenances(maintenances map[int]*Maintenance) error {
+	if s == nil {
+		return ErrStateNil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.maintenances = maintenances
+
+	return nil
+}
+
+// SetMaintenance sets the maintenance with the given id.
+func (s *State) SetMaintenance(id int, maintenance *Maintenance) error {
+	if s == nil {
+		return ErrStateNil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.maintenances == nil {
+		s.maintenances = make(map[int]*Maintenance)
+	}
+
+	s.maintenances[id] = maintenance
+
+	return nil
+}
@@ -1,80 +1,143 @@
 package state
 
-// Monitor represents a monitor object.
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+)
+
+// Monitor represents a monitor object as sent by Uptime Kuma 1.23 and 2.0. Fields that are not
+// available on all supported versions are omitted when encoding if they are not set, so the server
+// does not reject them when adding a monitor. Read-only fields computed by the server are never
+// encoded.
 type Monitor struct {
-	AcceptedStatuscodes      []string       `mapstructure:"accepted_statuscodes" json:"accepted_statuscodes"`
-	Active                   bool           `mapstructure:"active" json:"-"`
-	AuthDomain               *string        `mapstructure:"authDomain" json:"authDomain"`
-	AuthMethod               *string        `mapstructure:"authMethod" json:"authMethod"`
-	AuthWorkstation          *string        `mapstructure:"authWorkstation" json:"authWorkstation"`
-	BasicAuthPass            *string        `mapstructure:"basic_auth_pass" json:"basic_auth_pass"`
-	BasicAuthUser            *string        `mapstructure:"basic_auth_user" json:"basic_auth_user"`
-	Body                     *string        `mapstructure:"body" json:"body"`
-	ChildrenIds              []int          `mapstructure:"childrenIds" json:"-"`
-	DatabaseConnectionString *string        `mapstructure:"databaseConnectionString" json:"databaseConnectionString"`
-	DatabaseQuery            *string        `mapstructure:"databaseQuery" json:"databaseQuery"`
-	Description              *string        `mapstructure:"description" json:"description"`
-	DnsLastResult            *string        `mapstructure:"dns_last_result" json:"dns_last_result"`
-	DnsResolveServer         *string        `mapstructure:"dns_resolve_server" json:"dns_resolve_server"`
-	DnsResolveType           *string        `mapstructure:"dns_resolve_type" json:"dns_resolve_type"`
-	DockerContainer          *string        `mapstructure:"docker_container" json:"docker_container"`
-	DockerHost               *string        `mapstructure:"docker_host" json:"docker_host"`
-	ExpiryNotification       *bool          `mapstructure:"expiryNotification" json:"expiryNotification"`
-	ForceInactive            *bool          `mapstructure:"forceInactive" json:"-"`
-	Game                     *string        `mapstructure:"game" json:"game"`
-	GrpcBody                 *string        `mapstructure:"grpcBody" json:"grpcBody"`
-	GrpcEnableTls            bool           `mapstructure:"grpcEnableTls" json:"grpcEnableTls"`
-	GrpcMetadata             *string        `mapstructure:"grpcMetadata" json:"grpcMetadata"`
-	GrpcMethod               *string        `mapstructure:"grpcMethod" json:"grpcMethod"`
-	GrpcProtobuf             *string        `mapstructure:"grpcProtobuf" json:"grpcProtobuf"`
-	GrpcServiceName          *string        `mapstructure:"grpcServiceName" json:"grpcServiceName"`
-	GrpcUrl                  *string        `mapstructure:"grpcUrl" json:"grpcUrl"`
-	Headers                  []string       `mapstructure:"headers" json:"headers"`
-	Hostname                 *string        `mapstructure:"hostname" json:"hostname"`
-	HttpBodyEncoding         *string        `mapstructure:"httpBodyEncoding" json:"httpBodyEncoding"`
-	Id                       int            `mapstructure:"id" json:"-"`
-	IgnoreTls                bool           `mapstructure:"ignoreTls" json:"ignoreTls"`
-	IncludeSensitiveData     *bool          `mapstructure:"includeSensitiveData" json:"-"`
-	Interval                 int            `mapstructure:"interval" json:"interval"`
-	InvertKeyword            bool           `mapstructure:"invertKeyword" json:"invertKeyword"`
-	Keyword                  *string        `mapstructure:"keyword" json:"keyword"`
-	KeywordType              *string        `mapstructure:"keywordType" json:"-"`
-	Maintenance              *bool          `mapstructure:"maintenance" json:"-"`
-	Maxredirects             int            `mapstructure:"maxredirects" json:"maxredirects"`
-	Maxretries               int            `mapstructure:"maxretries" json:"maxretries"`
-	Method                   *string        `mapstructure:"method" json:"method"`
-	MqttPassword             *string        `mapstructure:"mqttPassword" json:"mqttPassword"`
-	MqttSuccessMessage       *string        `mapstructure:"mqttSuccessMessage" json:"mqttSuccessMessage"`
-	MqttTopic                *string        `mapstructure:"mqttTopic" json:"mqttTopic"`
-	MqttUsername             *string        `mapstructure:"mqttUsername" json:"mqttUsername"`
-	Name                     string         `mapstructure:"name" json:"name"`
-	NotificationIDList       map[int]string `mapstructure:"notificationIDList" json:"notificationIDList"`
-	PacketSize               int            `mapstructure:"packetSize" json:"packetSize"`
-	Parent                   *int           `mapstructure:"parent" json:"parent"`
-	PathName                 *string        `mapstructure:"pathName" json:"-"`
-	Port                     *int           `mapstructure:"port" json:"port"`
-	ProxyId                  *int           `mapstructure:"proxyId" json:"proxyId"`
-	PushToken                *string        `mapstructure:"pushToken" json:"pushToken"`
-	RadiusCalledStationId    *string        `mapstructure:"radiusCalledStationId" json:"radiusCalledStationId"`
-	RadiusCallingStationId   *string        `mapstructure:"radiusCallingStationId" json:"radiusCallingStationId"`
-	RadiusPassword           *string        `mapstructure:"radiusPassword" json:"radiusPassword"`
-	RadiusSecret             *string        `mapstructure:"radiusSecret" json:"radiusSecret"`
-	RadiusUsername           *string        `mapstructure:"radiusUsername" json:"radiusUsername"`
-	ResendInterval           int            `mapstructure:"resendInterval" json:"resendInterval"`
-	RetryInterval            int            `mapstructure:"retryInterval" json:"retryInterval"`
-	Tags                     []string       `mapstructure:"tags" json:"-"`
-	TlsCa                    *string        `mapstructure:"tlsCa" json:"tlsCa"`
-	TlsCert                  *string        `mapstructure:"tlsCert" json:"tlsCert"`
-	TlsKey                   *string        `mapstructure:"tlsKey" json:"tlsKey"`
-	Type                     string         `mapstructure:"type" json:"type"`
-	UpsideDown               bool           `mapstructure:"upsideDown" json:"upsideDown"`
-	Url                      *string        `mapstructure:"url" json:"url"`
-	Weight                   *int           `mapstructure:"weight" json:"weight"`
-
-	// additional fields that may be present but are not documented
+	AcceptedStatuscodes                 []string                  `mapstructure:"accepted_statuscodes" json:"accepted_statuscodes"`
+	Active                              bool                      `mapstructure:"active" json:"-"`
+	AuthDomain                          *string                   `mapstructure:"authDomain" json:"authDomain"`
+	AuthMethod                          *string                   `mapstructure:"authMethod" json:"authMethod"`
+	AuthWorkstation                     *string                   `mapstructure:"authWorkstation" json:"authWorkstation"`
+	BasicAuthPass                       *string                   `mapstructure:"basic_auth_pass" json:"basic_auth_pass"`
+	BasicAuthUser                       *string                   `mapstructure:"basic_auth_user" json:"basic_auth_user"`
+	Body                                *string                   `mapstructure:"body" json:"body"`
+	CacheBust                           *bool                     `mapstructure:"cacheBust" json:"cacheBust,omitempty"` // 2.0
+	ChildrenIds                         []int                     `mapstructure:"childrenIds" json:"-"`
+	Conditions                          []any                     `mapstructure:"conditions" json:"conditions,omitempty"` // 2.0
+	DatabaseConnectionString            *string                   `mapstructure:"databaseConnectionString" json:"databaseConnectionString"`
+	DatabaseQuery                       *string                   `mapstructure:"databaseQuery" json:"databaseQuery"`
+	Description                         *string                   `mapstructure:"description" json:"description"`
+	DnsLastResult                       *string                   `mapstructure:"dns_last_result" json:"dns_last_result"`
+	DnsResolveServer                    *string                   `mapstructure:"dns_resolve_server" json:"dns_resolve_server"`
+	DnsResolveType                      *string                   `mapstructure:"dns_resolve_type" json:"dns_resolve_type"`
+	DockerContainer                     *string                   `mapstructure:"docker_container" json:"docker_container"`
+	DockerHost                          *string                   `mapstructure:"docker_host" json:"docker_host"`
+	ExpectedValue                       *string                   `mapstructure:"expectedValue" json:"expectedValue,omitempty"`
+	ExpiryNotification                  *bool                     `mapstructure:"expiryNotification" json:"expiryNotification"`
+	ForceInactive                       *bool                     `mapstructure:"forceInactive" json:"-"`
+	Game                                *string                   `mapstructure:"game" json:"game"`
+	GamedigGivenPortOnly                *bool                     `mapstructure:"gamedigGivenPortOnly" json:"gamedigGivenPortOnly,omitempty"`
+	GrpcBody                            *string                   `mapstructure:"grpcBody" json:"grpcBody"`
+	GrpcEnableTls                       bool                      `mapstructure:"grpcEnableTls" json:"grpcEnableTls"`
+	GrpcMetadata                        *string                   `mapstructure:"grpcMetadata" json:"grpcMetadata"`
+	GrpcMethod                          *string                   `mapstructure:"grpcMethod" json:"grpcMethod"`
+	GrpcProtobuf                        *string                   `mapstructure:"grpcProtobuf" json:"grpcProtobuf"`
+	GrpcServiceName                     *string                   `mapstructure:"grpcServiceName" json:"grpcServiceName"`
+	GrpcUrl                             *string                   `mapstructure:"grpcUrl" json:"grpcUrl"`
+	Headers                             *string                   `mapstructure:"headers" json:"headers"` // JSON encoded object
+	Hostname                            *string                   `mapstructure:"hostname" json:"hostname"`
+	HttpBodyEncoding                    *string                   `mapstructure:"httpBodyEncoding" json:"httpBodyEncoding"`
+	Id                                  int                       `mapstructure:"id" json:"id,omitempty"`
+	IgnoreTls                           bool                      `mapstructure:"ignoreTls" json:"ignoreTls"`
+	IncludeSensitiveData                *bool                     `mapstructure:"includeSensitiveData" json:"-"`
+	Interval                            int                       `mapstructure:"interval" json:"interval"`
+	InvertKeyword                       bool                      `mapstructure:"invertKeyword" json:"invertKeyword"`
+	IpFamily                            *string                   `mapstructure:"ipFamily" json:"ipFamily,omitempty"` // 2.0
+	JsonPath                            *string                   `mapstructure:"jsonPath" json:"jsonPath,omitempty"`
+	JsonPathOperator                    *string                   `mapstructure:"jsonPathOperator" json:"jsonPathOperator,omitempty"` // 2.0
+	KafkaProducerAllowAutoTopicCreation *bool                     `mapstructure:"kafkaProducerAllowAutoTopicCreation" json:"kafkaProducerAllowAutoTopicCreation,omitempty"`
+	KafkaProducerBrokers                []string                  `mapstructure:"kafkaProducerBrokers" json:"kafkaProducerBrokers,omitempty"`
+	KafkaProducerMessage                *string                   `mapstructure:"kafkaProducerMessage" json:"kafkaProducerMessage,omitempty"`
+	KafkaProducerSaslOptions            *KafkaProducerSaslOptions `mapstructure:"kafkaProducerSaslOptions" json:"kafkaProducerSaslOptions,omitempty"`
+	KafkaProducerSsl                    *bool                     `mapstructure:"kafkaProducerSsl" json:"kafkaProducerSsl,omitempty"`
+	KafkaProducerTopic                  *string                   `mapstructure:"kafkaProducerTopic" json:"kafkaProducerTopic,omitempty"`
+	Keyword                             *string                   `mapstructure:"keyword" json:"keyword"`
+	KeywordType                         *string                   `mapstructure:"keywordType" json:"-"`
+	Maintenance                         *bool                     `mapstructure:"maintenance" json:"-"`
+	Maxredirects                        int                       `mapstructure:"maxredirects" json:"maxredirects"`
+	Maxretries                          int                       `mapstructure:"maxretries" json:"maxretries"`
+	Method                              *string                   `mapstructure:"method" json:"method"`
+	MqttPassword                        *string                   `mapstructure:"mqttPassword" json:"mqttPassword"`
+	MqttSuccessMessage                  *string                   `mapstructure:"mqttSuccessMessage" json:"mqttSuccessMessage"`
+	MqttTopic                           *string                   `mapstructure:"mqttTopic" json:"mqttTopic"`
+	MqttUsername                        *string                   `mapstructure:"mqttUsername" json:"mqttUsername"`
+	Name                                string                    `mapstructure:"name" json:"name"`
+	NotificationIDList                  map[int]bool              `mapstructure:"notificationIDList" json:"notificationIDList"`
+	OauthAuthMethod                     *string                   `mapstructure:"oauth_auth_method" json:"oauth_auth_method,omitempty"`
+	OauthClientId                       *string                   `mapstructure:"oauth_client_id" json:"oauth_client_id,omitempty"`
+	OauthClientSecret                   *string                   `mapstructure:"oauth_client_secret" json:"oauth_client_secret,omitempty"`
+	OauthScopes                         *string                   `mapstructure:"oauth_scopes" json:"oauth_scopes,omitempty"`
+	OauthTokenUrl                       *string                   `mapstructure:"oauth_token_url" json:"oauth_token_url,omitempty"`
+	PacketSize                          int                       `mapstructure:"packetSize" json:"packetSize"`
+	Parent                              *int                      `mapstructure:"parent" json:"parent"`
+	Path                                []string                  `mapstructure:"path" json:"-"` // 2.0
+	PathName                            *string                   `mapstructure:"pathName" json:"-"`
+	PingCount                           *int                      `mapstructure:"ping_count" json:"ping_count,omitempty"`                             // 2.0
+	PingNumeric                         *bool                     `mapstructure:"ping_numeric" json:"ping_numeric,omitempty"`                         // 2.0
+	PingPerRequestTimeout               *int                      `mapstructure:"ping_per_request_timeout" json:"ping_per_request_timeout,omitempty"` // 2.0
+	Port                                *int                      `mapstructure:"port" json:"port"`
+	ProxyId                             *int                      `mapstructure:"proxyId" json:"proxyId"`
+	PushToken                           *string                   `mapstructure:"pushToken" json:"pushToken"`
+	RabbitmqNodes                       []string                  `mapstructure:"rabbitmqNodes" json:"rabbitmqNodes,omitempty"`       // 2.0
+	RabbitmqPassword                    *string                   `mapstructure:"rabbitmqPassword" json:"rabbitmqPassword,omitempty"` // 2.0
+	RabbitmqUsername                    *string                   `mapstructure:"rabbitmqUsername" json:"rabbitmqUsername,omitempty"` // 2.0
+	RadiusCalledStationId               *string                   `mapstructure:"radiusCalledStationId" json:"radiusCalledStationId"`
+	RadiusCallingStationId              *string                   `mapstructure:"radiusCallingStationId" json:"radiusCallingStationId"`
+	RadiusPassword                      *string                   `mapstructure:"radiusPassword" json:"radiusPassword"`
+	RadiusSecret                        *string                   `mapstructure:"radiusSecret" json:"radiusSecret"`
+	RadiusUsername                      *string                   `mapstructure:"radiusUsername" json:"radiusUsername"`
+	RemoteBrowser                       *int                      `mapstructure:"remote_browser" json:"remote_browser,omitempty"`
+	ResendInterval                      int                       `mapstructure:"resendInterval" json:"resendInterval"` // down heartbeats between repeated notifications, 0 disables them
+	RetryInterval                       int                       `mapstructure:"retryInterval" json:"retryInterval"`
+	Screenshot                          *string                   `mapstructure:"screenshot" json:"-"`
+	SmtpSecurity                        *string                   `mapstructure:"smtpSecurity" json:"smtpSecurity,omitempty"` // 2.0
+	SnmpOid                             *string                   `mapstructure:"snmpOid" json:"snmpOid,omitempty"`           // 2.0
+	SnmpVersion                         *string                   `mapstructure:"snmpVersion" json:"snmpVersion,omitempty"`   // 2.0
+	Tags                                []MonitorTag              `mapstructure:"tags" json:"-"`
+	Timeout                             *float64                  `mapstructure:"timeout" json:"timeout,omitempty"` // seconds
+	TlsCa                               *string                   `mapstructure:"tlsCa" json:"tlsCa"`
+	TlsCert                             *string                   `mapstructure:"tlsCert" json:"tlsCert"`
+	TlsExpiryNotification               *bool                     `mapstructure:"tlsExpiryNotification" json:"tlsExpiryNotification,omitempty"`
+	TlsKey                              *string                   `mapstructure:"tlsKey" json:"tlsKey"`
+	Type                                string                    `mapstructure:"type" json:"type"`
+	UpsideDown                          bool                      `mapstructure:"upsideDown" json:"upsideDown"`
+	Url                                 *string                   `mapstructure:"url" json:"url"`
+	Weight                              *int                      `mapstructure:"weight" json:"weight"`
+
+	// additional fields that may be present but are not documented, they are encoded as well so
+	// that fields of newer versions survive editing a monitor
 	Unmapped map[string]any `mapstructure:",remain" json:"-"`
 }
 
+// KafkaProducerSaslOptions are the SASL options of a kafka producer monitor.
+type KafkaProducerSaslOptions struct {
+	Mechanism string  `mapstructure:"mechanism" json:"mechanism"`
+	Username  *string `mapstructure:"username" json:"username,omitempty"`
+	Password  *string `mapstructure:"password" json:"password,omitempty"`
+}
+
+// MarshalJSON encodes the monitor including its unmapped fields, which never override the mapped
+// ones.
+func (m Monitor) MarshalJSON() ([]byte, error) {
+	type monitor Monitor
+
+	data, err := utils.EncodeMap(monitor(m))
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(data)
+}
+
 // Monitors returns all monitors received from Uptime Kuma.
 func (s *State) Monitors() (map[int]*Monitor, error) {
 	if s == nil {
@@ -112,6 +175,20 @@ func (s *State) Monitor(monitorId int) (*Monitor, error) {
 	return monitor, nil
 }
 
+// PushToken returns the push token of the push monitor with the given id.
+func (s *State) PushToken(monitorId int) (string, error) {
+	monitor, err := s.Monitor(monitorId)
+	if err != nil {
+		return "", err
+	}
+
+	if monitor.Type != "push" || monitor.PushToken == nil || *monitor.PushToken == "" {
+		return "", fmt.Errorf("%w: monitor %d", ErrNoPushToken, monitorId)
+	}
+
+	return *monitor.PushToken, nil
+}
+
 // SetMonitors sets the monitors received from Uptime Kuma.
 func (s *State) SetMonitors(monitors map[int]*Monitor) error {
 	if s == nil {
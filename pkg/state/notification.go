@@ -0,0 +1,127 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Notification represents a notification as sent by Uptime Kuma in the notificationList event.
+type Notification struct {
+	Id        int    `mapstructure:"id" json:"id"`
+	Name      string `mapstructure:"name" json:"name"`
+	Active    bool   `mapstructure:"active" json:"active"`
+	IsDefault bool   `mapstructure:"is_default" json:"is_default"`
+	UserId    int    `mapstructure:"user_id" json:"user_id"`
+
+	// Config is the JSON encoded configuration of the notification, including the provider
+	// specific fields.
+	Config string `mapstructure:"config" json:"config"`
+}
+
+// DecodeConfig decodes the JSON encoded configuration of the notification.
+func (n *Notification) DecodeConfig() (map[string]any, error) {
+	config := map[string]any{}
+	if n.Config == "" {
+		return config, nil
+	}
+
+	if err := json.Unmarshal([]byte(n.Config), &config); err != nil {
+		return nil, fmt.Errorf("decode config of notification %d failed: %w", n.Id, err)
+	}
+
+	return config, nil
+}
+
+// Notifications returns the notifications received from Uptime Kuma.
+func (s *State) Notifications() ([]Notification, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.notifications == nil {
+		return nil, ErrNotSetYet
+	}
+
+	// Convert map to slice.
+	notifications := make([]Notification, 0, len(s.notifications))
+	for _, notification := range s.notifications {
+		notifications = append(notifications, *notification)
+	}
+
+	return notifications, nil
+}
+
+// Notification returns the notification with the given id.
+func (s *State) Notification(notificationId int) (*Notification, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.notifications == nil {
+		return nil, ErrNotSetYet
+	}
+
+	notification, ok := s.notifications[notificationId]
+	if !ok {
+		return nil, NewErrNotFound("notification", notificationId)
+	}
+
+	return notification, nil
+}
+
+// NotificationByName returns the notification with the given name. Names are not unique in Uptime
+// Kuma, if several notifications share the name, the one with the lowest id is returned.
+func (s *State) NotificationByName(name string) (*Notification, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.notifications == nil {
+		return nil, ErrNotSetYet
+	}
+
+	var found *Notification
+
+	for _, notification := range s.notifications {
+		if notification.Name != name {
+			continue
+		}
+
+		if found == nil || notification.Id < found.Id {
+			found = notification
+		}
+	}
+
+	if found == nil {
+		return nil, NewErrNotFoundByName("notification", name)
+	}
+
+	return found, nil
+}
+
+// SetNotifications sets the notifications received from Uptime Kuma.
+func (s *State) SetNotifications(notifications []Notification) error {
+	if s == nil {
+		return ErrStateNil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Convert slice to map.
+	s.notifications = make(map[int]*Notification, len(notifications))
+	for i := range notifications {
+		s.notifications[notifications[i].Id] = &notifications[i]
+	}
+
+	return nil
+}
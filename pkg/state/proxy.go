@@ -0,0 +1,99 @@
+package state
+
+// Proxy represents a proxy as sent by Uptime Kuma in the proxyList event.
+type Proxy struct {
+	Id          int     `mapstructure:"id" json:"id"`
+	UserId      int     `mapstructure:"user_id" json:"user_id"`
+	Protocol    string  `mapstructure:"protocol" json:"protocol"`
+	Host        string  `mapstructure:"host" json:"host"`
+	Port        int     `mapstructure:"port" json:"port"`
+	Auth        bool    `mapstructure:"auth" json:"auth"`
+	Username    *string `mapstructure:"username" json:"username"`
+	Password    *string `mapstructure:"password" json:"password"`
+	Active      bool    `mapstructure:"active" json:"active"`
+	Default     bool    `mapstructure:"default" json:"default"`
+	CreatedDate *string `mapstructure:"created_date" json:"created_date"`
+}
+
+// Proxies returns the proxies received from Uptime Kuma.
+func (s *State) Proxies() ([]Proxy, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.proxies == nil {
+		return nil, ErrNotSetYet
+	}
+
+	// Convert map to slice.
+	proxies := make([]Proxy, 0, len(s.proxies))
+	for _, proxy := range s.proxies {
+		proxies = append(proxies, *proxy)
+	}
+
+	return proxies, nil
+}
+
+// Proxy returns the proxy with the given id.
+func (s *State) Proxy(proxyId int) (*Proxy, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.proxies == nil {
+		return nil, ErrNotSetYet
+	}
+
+	proxy, ok := s.proxies[proxyId]
+	if !ok {
+		return nil, NewErrNotFound("proxy", proxyId)
+	}
+
+	return proxy, nil
+}
+
+// DefaultProxy returns the proxy that is applied to new monitors by default.
+func (s *State) DefaultProxy() (*Proxy, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.proxies == nil {
+		return nil, ErrNotSetYet
+	}
+
+	for _, proxy := range s.proxies {
+		if proxy.Default {
+			return proxy, nil
+		}
+	}
+
+	return nil, NewErrNotFoundByName("proxy", "default")
+}
+
+// SetProxies sets the proxies received from Uptime Kuma.
+func (s *State) SetProxies(proxies []Proxy) error {
+	if s == nil {
+		return ErrStateNil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Convert slice to map.
+	s.proxies = make(map[int]*Proxy, len(proxies))
+	for i := range proxies {
+		s.proxies[proxies[i].Id] = &proxies[i]
+	}
+
+	return nil
+}
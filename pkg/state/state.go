@@ -23,6 +23,9 @@ type State struct {
 	// Stores the auto login state of the client.
 	autoLogin *bool
 
+	// Stores the token of the last successful login.
+	token *string
+
 	// Stores the info data.
 	info *Info
 
@@ -37,6 +40,39 @@ type State struct {
 
 	// Stores the tags
 	tags map[int]*Tag
+
+	// Stores the notifications.
+	notifications map[int]*Notification
+
+	// Stores the proxies.
+	proxies map[int]*Proxy
+
+	// Stores the maintenances.
+	maintenances map[int]*Maintenance
+
+	// Stores the status pages.
+	statusPages map[int]*StatusPage
+
+	// Stores the API keys.
+	apiKeys map[int]*APIKey
+
+	// Stores the docker hosts.
+	dockerHosts map[int]*DockerHost
+
+	// Stores the uptimes per monitor and window.
+	uptimes map[int]map[UptimeWindow]float64
+
+	// Stores the average pings per monitor.
+	avgPings map[int]float64
+
+	// Stores the TLS information per monitor.
+	certInfos map[int]*CertInfo
+
+	// Stores the status of the Cloudflare Tunnel.
+	cloudflared *Cloudflared
+
+	// Stores the games supported by gamedig monitors.
+	games []Game
 }
 
 // NewState creates a new empty state instance.
@@ -46,11 +82,23 @@ func NewState() *State {
 		connected:           nil,
 		loggedIn:            utils.NewBool(false),
 		autoLogin:           nil,
+		token:               nil,
 		info:                nil,
 		monitors:            nil,
 		heartbeats:          nil,
 		importantHeartbeats: nil,
 		tags:                nil,
+		notifications:       nil,
+		proxies:             nil,
+		maintenances:        nil,
+		statusPages:         nil,
+		apiKeys:             nil,
+		dockerHosts:         nil,
+		uptimes:             nil,
+		avgPings:            nil,
+		certInfos:           nil,
+		cloudflared:         nil,
+		games:               nil,
 	}
 }
 
@@ -0,0 +1,163 @@
+package state
+
+// StatusPage represents the configuration of a status page.
+type StatusPage struct {
+	Id          int    `mapstructure:"id" json:"id,omitempty"`
+	Slug        string `mapstructure:"slug" json:"slug"`
+	Title       string `mapstructure:"title" json:"title"`
+	Description string `mapstructure:"description" json:"description"`
+
+	// Icon is the path of the logo, e.g. "/icon.svg". When saving a status page, it may be a data
+	// URL to upload a new logo.
+	Icon string `mapstructure:"icon" json:"icon"`
+
+	// Theme is either "auto", "light" or "dark".
+	Theme string `mapstructure:"theme" json:"theme"`
+
+	// AutoRefreshInterval is the refresh interval of the status page in seconds.
+	AutoRefreshInterval   int      `mapstructure:"autoRefreshInterval" json:"autoRefreshInterval"`
+	Published             bool     `mapstructure:"published" json:"published"`
+	ShowTags              bool     `mapstructure:"showTags" json:"showTags"`
+	DomainNameList        []string `mapstructure:"domainNameList" json:"domainNameList"`
+	CustomCSS             *string  `mapstructure:"customCSS" json:"customCSS"`
+	FooterText            *string  `mapstructure:"footerText" json:"footerText"`
+	ShowPoweredBy         bool     `mapstructure:"showPoweredBy" json:"showPoweredBy"`
+	GoogleAnalyticsId     *string  `mapstructure:"googleAnalyticsId" json:"googleAnalyticsId"`
+	ShowCertificateExpiry bool     `mapstructure:"showCertificateExpiry" json:"showCertificateExpiry"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+// PublicGroup represents a group of monitors shown on a status page.
+type PublicGroup struct {
+	Id          int             `mapstructure:"id" json:"id,omitempty"`
+	Name        string          `mapstructure:"name" json:"name"`
+	Weight      int             `mapstructure:"weight" json:"weight,omitempty"`
+	MonitorList []PublicMonitor `mapstructure:"monitorList" json:"monitorList"`
+}
+
+// PublicMonitor represents a monitor shown in a public group of a status page.
+type PublicMonitor struct {
+	Id int `mapstructure:"id" json:"id"`
+
+	// SendUrl shows the URL of the monitor on the status page.
+	SendUrl bool `mapstructure:"sendUrl" json:"sendUrl"`
+
+	// fields sent by Uptime Kuma, they are ignored when saving a status page
+	Name                    string `mapstructure:"name" json:"-"`
+	Type                    string `mapstructure:"type" json:"-"`
+	Url                     string `mapstructure:"url" json:"-"`
+	Tags                    []Tag  `mapstructure:"tags" json:"-"`
+	CertExpiryDaysRemaining *int   `mapstructure:"certExpiryDaysRemaining" json:"-"`
+	ValidCert               *bool  `mapstructure:"validCert" json:"-"`
+
+	// additional fields that may be present but are not documented
+	Unmapped map[string]any `mapstructure:",remain" json:"-"`
+}
+
+// Incident represents an incident posted on a status page.
+type Incident struct {
+	Id      int    `mapstructure:"id" json:"id,omitempty"`
+	Title   string `mapstructure:"title" json:"title"`
+	Content string `mapstructure:"content" json:"content"`
+
+	// Style is one of "info", "warning", "danger", "primary", "light" or "dark".
+	Style string `mapstructure:"style" json:"style"`
+
+	// fields computed by Uptime Kuma, they are ignored when posting an incident
+	Pin             bool    `mapstructure:"pin" json:"-"`
+	CreatedDate     string  `mapstructure:"createdDate" json:"-"`
+	LastUpdatedDate *string `mapstructure:"lastUpdatedDate" json:"-"`
+}
+
+// StatusPages returns all status pages received from Uptime Kuma.
+func (s *State) StatusPages() (map[int]*StatusPage, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.statusPages == nil {
+		return nil, ErrNotSetYet
+	}
+
+	return s.statusPages, nil
+}
+
+// StatusPage returns the status page with the given id.
+func (s *State) StatusPage(statusPageId int) (*StatusPage, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.statusPages == nil {
+		return nil, ErrNotSetYet
+	}
+
+	statusPage, ok := s.statusPages[statusPageId]
+	if !ok {
+		return nil, NewErrNotFound("status page", statusPageId)
+	}
+
+	return statusPage, nil
+}
+
+// StatusPageBySlug returns the status page with the given slug.
+func (s *State) StatusPageBySlug(slug string) (*StatusPage, error) {
+	if s == nil {
+		return nil, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.statusPages == nil {
+		return nil, ErrNotSetYet
+	}
+
+	for _, statusPage := range s.statusPages {
+		if statusPage.Slug == slug {
+			return statusPage, nil
+		}
+	}
+
+	return nil, NewErrNotFoundByName("status page", slug)
+}
+
+// SetStatusPages sets the status pages received from Uptime Kuma.
+func (s *State) SetStatusPages(statusPages map[int]*StatusPage) error {
+	if s == nil {
+		return ErrStateNil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.statusPages = statusPages
+
+	return nil
+}
+
+// SetStatusPage sets the status page with the given id.
+func (s *State) SetStatusPage(id int, statusPage *StatusPage) error {
+	if s == nil {
+		return ErrStateNil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.statusPages == nil {
+		s.statusPages = make(map[int]*StatusPage)
+	}
+
+	s.statusPages[id] = statusPage
+
+	return nil
+}
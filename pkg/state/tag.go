@@ -4,9 +4,19 @@ import "fmt"
 
 // Tag represents a tag object.
 type Tag struct {
-	Color string `mapstructure:"color"`
-	Id    int    `mapstructure:"id"`
-	Name  string `mapstructure:"name"`
+	Color string `mapstructure:"color" json:"color"`
+	Id    int    `mapstructure:"id" json:"id"`
+	Name  string `mapstructure:"name" json:"name"`
+}
+
+// MonitorTag represents a tag assigned to a monitor, including the value of the assignment.
+type MonitorTag struct {
+	Id        int    `mapstructure:"id" json:"id"`
+	MonitorId int    `mapstructure:"monitor_id" json:"monitor_id"`
+	TagId     int    `mapstructure:"tag_id" json:"tag_id"`
+	Value     string `mapstructure:"value" json:"value"`
+	Name      string `mapstructure:"name" json:"name"`
+	Color     string `mapstructure:"color" json:"color"`
 }
 
 // Tag returns the tag with the given id.
@@ -0,0 +1,93 @@
+package state
+
+// UptimeWindow is the period an uptime percentage sent in the uptime event is calculated for.
+type UptimeWindow string
+
+const (
+	UptimeWindow24h UptimeWindow = "24"  // the last 24 hours
+	UptimeWindow30d UptimeWindow = "720" // the last 30 days
+	UptimeWindow1y  UptimeWindow = "1y"  // the last year, only sent by Uptime Kuma 2.0
+)
+
+// Uptime returns the uptime of the monitor with the given id in the given window, as a fraction
+// between 0 and 1.
+func (s *State) Uptime(monitorId int, window UptimeWindow) (float64, error) {
+	if s == nil {
+		return 0, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.uptimes == nil {
+		return 0, ErrNotSetYet
+	}
+
+	uptime, ok := s.uptimes[monitorId][window]
+	if !ok {
+		return 0, NewErrNotFound("uptime "+string(window), monitorId)
+	}
+
+	return uptime, nil
+}
+
+// SetUptime sets the uptime of the monitor with the given id in the given window.
+func (s *State) SetUptime(monitorId int, window UptimeWindow, uptime float64) error {
+	if s == nil {
+		return ErrStateNil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.uptimes == nil {
+		s.uptimes = make(map[int]map[UptimeWindow]float64)
+	}
+
+	if _, ok := s.uptimes[monitorId]; !ok {
+		s.uptimes[monitorId] = make(map[UptimeWindow]float64)
+	}
+
+	s.uptimes[monitorId][window] = uptime
+
+	return nil
+}
+
+// AvgPing returns the average response time of the monitor with the given id in milliseconds.
+func (s *State) AvgPing(monitorId int) (float64, error) {
+	if s == nil {
+		return 0, ErrStateNil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.avgPings == nil {
+		return 0, ErrNotSetYet
+	}
+
+	avgPing, ok := s.avgPings[monitorId]
+	if !ok {
+		return 0, NewErrNotFound("average ping", monitorId)
+	}
+
+	return avgPing, nil
+}
+
+// SetAvgPing sets the average response time of the monitor with the given id in milliseconds.
+func (s *State) SetAvgPing(monitorId int, avgPing float64) error {
+	if s == nil {
+		return ErrStateNil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.avgPings == nil {
+		s.avgPings = make(map[int]float64)
+	}
+
+	s.avgPings[monitorId] = avgPing
+
+	return nil
+}
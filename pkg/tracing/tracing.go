@@ -0,0 +1,133 @@
+// Package tracing provides OpenTelemetry tracing for the client. Spans are created for every
+// emitted action and every received event, using the interceptors of the client:
+//
+//	c, err := client.NewClient(host, port, secure,
+//		client.WithInterceptors(tracing.Interceptor()),
+//		client.WithEventInterceptors(tracing.EventInterceptor()),
+//	)
+package tracing
+
+import (
+	"context"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/action"
+	"github.com/nobbs/uptime-kuma-api/pkg/client"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// instrumentationName is the name of the tracer.
+	instrumentationName = "github.com/nobbs/uptime-kuma-api/pkg/tracing"
+)
+
+const (
+	// ActionKey is the attribute key for the name of an emitted action.
+	ActionKey = attribute.Key("uptime_kuma.action")
+
+	// EventKey is the attribute key for the name of a received event.
+	EventKey = attribute.Key("uptime_kuma.event")
+
+	// MonitorIdKey is the attribute key for the id of the monitor an action has been called for.
+	MonitorIdKey = attribute.Key("uptime_kuma.monitor_id")
+
+	// OkKey is the attribute key for the ok flag of an action response.
+	OkKey = attribute.Key("uptime_kuma.ok")
+
+	// MsgKey is the attribute key for the message of an action response.
+	MsgKey = attribute.Key("uptime_kuma.msg")
+)
+
+// Option configures the tracing interceptors.
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+}
+
+// newConfig returns the default config with the given options applied.
+func newConfig(opts ...Option) *config {
+	c := &config{}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.tracerProvider == nil {
+		c.tracerProvider = otel.GetTracerProvider()
+	}
+
+	return c
+}
+
+// WithTracerProvider sets the tracer provider used to create spans. Defaults to the global tracer
+// provider.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// Interceptor returns a client interceptor that creates a span for every emitted action. The span
+// is a child of the span in the context passed to the action.
+func Interceptor(opts ...Option) client.Interceptor {
+	tracer := newConfig(opts...).tracerProvider.Tracer(instrumentationName)
+
+	return func(ctx context.Context, event string, args []any, invoker client.Invoker) (any, error) {
+		attrs := []attribute.KeyValue{ActionKey.String(event)}
+		if monitorId, ok := action.MonitorId(event, args); ok {
+			attrs = append(attrs, MonitorIdKey.Int(monitorId))
+		}
+
+		ctx, span := tracer.Start(ctx, event, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+		defer span.End()
+
+		response, err := invoker(ctx, event, args...)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			return response, err
+		}
+
+		// record the status of the response, if it has one
+		if status, err := action.DecodeStatus(response); err == nil {
+			span.SetAttributes(OkKey.Bool(status.Ok))
+
+			if status.Msg != nil {
+				span.SetAttributes(MsgKey.String(*status.Msg))
+			}
+
+			if !status.Ok {
+				span.SetStatus(codes.Error, "action failed")
+			}
+		}
+
+		return response, nil
+	}
+}
+
+// EventInterceptor returns a client event interceptor that creates a span for every received event.
+// As events are pushed by the server, their spans have no parent.
+func EventInterceptor(opts ...Option) client.EventInterceptor {
+	tracer := newConfig(opts...).tracerProvider.Tracer(instrumentationName)
+
+	return func(event string, handle func() error) error {
+		_, span := tracer.Start(context.Background(), event,
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(EventKey.String(event)),
+		)
+		defer span.End()
+
+		if err := handle(); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			return err
+		}
+
+		return nil
+	}
+}
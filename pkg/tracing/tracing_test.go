@@ -0,0 +1,154 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newProvider() (*sdktrace.TracerProvider, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	return sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)), exporter
+}
+
+func TestInterceptor(t *testing.T) {
+	type args struct {
+		event    string
+		args     []any
+		response any
+		err      error
+	}
+
+	tests := []struct {
+		name   string
+		args   *args
+		attrs  []attribute.KeyValue
+		status codes.Code
+	}{
+		{
+			name: "ok",
+			args: &args{
+				event:    "getMonitor",
+				args:     []any{12},
+				response: []any{[]byte(`{"ok":true}`)},
+			},
+			attrs: []attribute.KeyValue{
+				tracing.ActionKey.String("getMonitor"),
+				tracing.MonitorIdKey.Int(12),
+				tracing.OkKey.Bool(true),
+			},
+			status: codes.Unset,
+		},
+		{
+			name: "not ok",
+			args: &args{
+				event:    "deleteMonitorTag",
+				args:     []any{1, 12, "value"},
+				response: []any{[]byte(`{"ok":false,"msg":"Not found"}`)},
+			},
+			attrs: []attribute.KeyValue{
+				tracing.ActionKey.String("deleteMonitorTag"),
+				tracing.MonitorIdKey.Int(12),
+				tracing.OkKey.Bool(false),
+				tracing.MsgKey.String("Not found"),
+			},
+			status: codes.Error,
+		},
+		{
+			name: "emit failed",
+			args: &args{
+				event: "getTags",
+				err:   errors.New("timeout"),
+			},
+			attrs: []attribute.KeyValue{
+				tracing.ActionKey.String("getTags"),
+			},
+			status: codes.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// setup tracer
+			tp, exporter := newProvider()
+			interceptor := tracing.Interceptor(tracing.WithTracerProvider(tp))
+
+			ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+
+			// run function
+			response, err := interceptor(ctx, tt.args.event, tt.args.args, func(ctx context.Context, event string, args ...any) (any, error) {
+				assert.True(t, trace.SpanContextFromContext(ctx).IsValid())
+				return tt.args.response, tt.args.err
+			})
+
+			parent.End()
+
+			// assert results
+			assert.Equal(t, tt.args.response, response)
+			assert.Equal(t, tt.args.err, err)
+
+			spans := exporter.GetSpans()
+			if assert.Len(t, spans, 2) {
+				assert.Equal(t, tt.args.event, spans[0].Name)
+				assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind)
+				assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+				assert.ElementsMatch(t, tt.attrs, spans[0].Attributes)
+				assert.Equal(t, tt.status, spans[0].Status.Code)
+			}
+		})
+	}
+}
+
+func TestEventInterceptor(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status codes.Code
+	}{
+		{
+			name:   "ok",
+			err:    nil,
+			status: codes.Unset,
+		},
+		{
+			name:   "handler failed",
+			err:    errors.New("decode failed"),
+			status: codes.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// setup tracer
+			tp, exporter := newProvider()
+			interceptor := tracing.EventInterceptor(tracing.WithTracerProvider(tp))
+
+			// run function
+			called := false
+			err := interceptor("heartbeat", func() error {
+				called = true
+				return tt.err
+			})
+
+			// assert results
+			assert.True(t, called)
+			assert.Equal(t, tt.err, err)
+
+			spans := exporter.GetSpans()
+			if assert.Len(t, spans, 1) {
+				assert.Equal(t, "heartbeat", spans[0].Name)
+				assert.Equal(t, trace.SpanKindConsumer, spans[0].SpanKind)
+				assert.Equal(t, []attribute.KeyValue{tracing.EventKey.String("heartbeat")}, spans[0].Attributes)
+				assert.Equal(t, tt.status, spans[0].Status.Code)
+			}
+		})
+	}
+}
@@ -1,14 +1,59 @@
 package utils
 
 import (
+	"reflect"
 	"strconv"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 )
 
+// TimeLayout is the layout of the dates sent by Uptime Kuma. They are in UTC unless noted
+// otherwise.
+const TimeLayout = "2006-01-02 15:04:05"
+
+// timeLayouts are the layouts tried in order when decoding a string into a time.Time.
+var timeLayouts = []string{TimeLayout, time.RFC3339Nano, "2006-01-02 15:04", "2006-01-02"}
+
 // Decode is a wrapper around mapstructure.Decode
 func Decode(data any, response any) error {
-	return mapstructure.WeakDecode(data, response)
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       stringToTimeHook,
+		Result:           response,
+		WeaklyTypedInput: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(data)
+}
+
+// stringToTimeHook decodes the dates sent by Uptime Kuma into time.Time values, see TimeLayout.
+func stringToTimeHook(from reflect.Type, to reflect.Type, data any) (any, error) {
+	if from.Kind() != reflect.String || to != reflect.TypeOf(time.Time{}) {
+		return data, nil
+	}
+
+	return ParseTime(data.(string))
+}
+
+// ParseTime parses a date sent by Uptime Kuma, see TimeLayout. An empty string is the zero time.
+func ParseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	var err error
+
+	for _, layout := range timeLayouts {
+		var t time.Time
+		if t, err = time.ParseInLocation(layout, value, time.UTC); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
 }
 
 // DecodeMap is a wrapper around mapstructure.WeakDecode for multiple
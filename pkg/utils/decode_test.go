@@ -0,0 +1,56 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode_Time(t *testing.T) {
+	type data struct {
+		Created time.Time  `mapstructure:"created"`
+		Expires *time.Time `mapstructure:"expires"`
+	}
+
+	var got data
+	assert.NoError(t, utils.Decode(map[string]any{
+		"created": "2023-10-01 12:30:45",
+		"expires": "2024-01-01T00:00:00Z",
+	}, &got))
+
+	expires := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, data{
+		Created: time.Date(2023, 10, 1, 12, 30, 45, 0, time.UTC),
+		Expires: &expires,
+	}, got)
+
+	got = data{}
+	assert.NoError(t, utils.Decode(map[string]any{"created": "", "expires": nil}, &got))
+	assert.Equal(t, data{}, got)
+
+	assert.Error(t, utils.Decode(map[string]any{"created": "yesterday"}, &got))
+}
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{value: "2023-10-01 12:30:45", want: time.Date(2023, 10, 1, 12, 30, 45, 0, time.UTC)},
+		{value: "2023-10-01 12:30", want: time.Date(2023, 10, 1, 12, 30, 0, 0, time.UTC)},
+		{value: "2023-10-01", want: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{value: "2023-10-01T12:30:45.5+02:00", want: time.Date(2023, 10, 1, 10, 30, 45, 500000000, time.UTC)},
+		{value: "", want: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := utils.ParseTime(tt.value)
+
+			assert.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+		})
+	}
+}
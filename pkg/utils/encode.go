@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// EncodeMap encodes the given struct into a map using its json tags. It is the counterpart of
+// Decode: the entries of a map field tagged with `mapstructure:",remain"` are added to the result,
+// unless a regular field with the same key exists.
+func EncodeMap(data any) (map[string]any, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]any{}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(data))
+	if v.Kind() != reflect.Struct {
+		return result, nil
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !strings.Contains(field.Tag.Get("mapstructure"), ",remain") || field.Type.Kind() != reflect.Map {
+			continue
+		}
+
+		iter := v.Field(i).MapRange()
+		for iter.Next() {
+			key, ok := iter.Key().Interface().(string)
+			if !ok {
+				continue
+			}
+
+			if _, exists := result[key]; !exists {
+				result[key] = iter.Value().Interface()
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,63 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/nobbs/uptime-kuma-api/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeMap(t *testing.T) {
+	type data struct {
+		Name     string         `mapstructure:"name" json:"name"`
+		Count    *int           `mapstructure:"count" json:"count,omitempty"`
+		Unmapped map[string]any `mapstructure:",remain" json:"-"`
+	}
+
+	tests := []struct {
+		name string
+		data any
+		want map[string]any
+	}{
+		{
+			name: "fields",
+			data: data{Name: "test", Count: utils.NewInt(1)},
+			want: map[string]any{"name": "test", "count": float64(1)},
+		},
+		{
+			name: "omitted",
+			data: &data{Name: "test"},
+			want: map[string]any{"name": "test"},
+		},
+		{
+			name: "unmapped",
+			data: &data{Name: "test", Unmapped: map[string]any{"extra": true, "name": "ignored"}},
+			want: map[string]any{"name": "test", "extra": true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := utils.EncodeMap(tt.data)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEncodeMap_RoundTrip(t *testing.T) {
+	type data struct {
+		Name     string         `mapstructure:"name" json:"name"`
+		Unmapped map[string]any `mapstructure:",remain" json:"-"`
+	}
+
+	in := map[string]any{"name": "test", "extra": "value"}
+
+	var d data
+	assert.NoError(t, utils.Decode(in, &d))
+
+	got, err := utils.EncodeMap(d)
+	assert.NoError(t, err)
+	assert.Equal(t, in, got)
+}
@@ -3,6 +3,7 @@
 package testutil
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -139,7 +140,7 @@ func (s *UptimeKumaServer) NewClientWithLoginByUsernameAndPassword() (*client.Cl
 	}
 
 	// login
-	jwtToken, err := action.Login(c, *s.username, *s.password, "")
+	jwtToken, err := action.Login(context.Background(), c, *s.username, *s.password, "")
 	if err != nil {
 		return nil, fmt.Errorf("Failed to login: %w", err)
 	}
@@ -160,7 +161,7 @@ func (s *UptimeKumaServer) NewClientWithLoginByJWTToken() (*client.Client, error
 	}
 
 	// login
-	if err := action.LoginByToken(c, *s.jwtToken); err != nil {
+	if err := action.LoginByToken(context.Background(), c, *s.jwtToken); err != nil {
 		return nil, fmt.Errorf("Failed to login by token: %w", err)
 	}
 
@@ -182,14 +183,14 @@ func (s *UptimeKumaServer) SetupUser(username, password string) error {
 	s.password = &password
 
 	// check if we need to setup
-	needSetup, err := action.NeedSetup(c)
+	needSetup, err := action.NeedSetup(context.Background(), c)
 	if err != nil {
 		return fmt.Errorf("Failed to check if we need to setup: %w", err)
 	}
 
 	// if we need to setup, do it
 	if needSetup {
-		err = action.Setup(c, *s.username, *s.password)
+		err = action.Setup(context.Background(), c, *s.username, *s.password)
 		if err != nil {
 			return fmt.Errorf("Failed to setup: %w", err)
 		}